@@ -0,0 +1,176 @@
+package alert
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Condition compares one snapshot field with a threshold.
+type Condition struct {
+	Field     string
+	Op        string // >, >=, <, <=, == or !=
+	Threshold float64
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("%s %s %s", c.Field, c.Op, strconv.FormatFloat(c.Threshold, 'f', -1, 64))
+}
+
+// Expr is a parsed rule condition: conditions ANDed within each group, and
+// groups ORed.
+type Expr [][]Condition
+
+// Parse reads a rule condition. The grammar is
+//
+//	expr      = group { "or" group }
+//	group     = condition { "and" condition }
+//	condition = field op number
+//
+// where field is a JSON field name of the snapshot, op one of >, >=, <, <=,
+// == and !=, and number may carry a $ prefix, _ separators and a k, m or b
+// suffix. "and" binds tighter than "or":
+//
+//	priceChange5minPercentage > 20 and liquidityUSD >= $10k
+func Parse(s string) (Expr, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("alert: empty condition")
+	}
+	var expr Expr
+	var group []Condition
+	for i := 0; i < len(fields); {
+		if i+3 > len(fields) {
+			return nil, fmt.Errorf("alert: incomplete condition %q", strings.Join(fields[i:], " "))
+		}
+		field, op, raw := fields[i], fields[i+1], fields[i+2]
+		if !validOp(op) {
+			return nil, fmt.Errorf("alert: unknown operator %q in %q", op, s)
+		}
+		threshold, err := parseNumber(raw)
+		if err != nil {
+			return nil, fmt.Errorf("alert: bad number %q in %q", raw, s)
+		}
+		group = append(group, Condition{Field: field, Op: op, Threshold: threshold})
+		i += 3
+		if i == len(fields) {
+			break
+		}
+		switch strings.ToLower(fields[i]) {
+		case "and":
+		case "or":
+			expr = append(expr, group)
+			group = nil
+		default:
+			return nil, fmt.Errorf("alert: expected and/or, got %q in %q", fields[i], s)
+		}
+		i++
+		if i == len(fields) {
+			return nil, fmt.Errorf("alert: condition %q ends with %s", s, fields[i-1])
+		}
+	}
+	return append(expr, group), nil
+}
+
+func (e Expr) String() string {
+	groups := make([]string, len(e))
+	for i, g := range e {
+		conds := make([]string, len(g))
+		for j, c := range g {
+			conds[j] = c.String()
+		}
+		groups[i] = strings.Join(conds, " and ")
+	}
+	return strings.Join(groups, " or ")
+}
+
+// Fields returns the fields the expression reads, in order of appearance.
+func (e Expr) Fields() []string {
+	var fields []string
+	seen := map[string]bool{}
+	for _, g := range e {
+		for _, c := range g {
+			if !seen[c.Field] {
+				seen[c.Field] = true
+				fields = append(fields, c.Field)
+			}
+		}
+	}
+	return fields
+}
+
+// eval evaluates e against values. hysteresis widens every threshold by
+// that fraction of itself in the direction that keeps a condition true,
+// which is how a fired rule is held active.
+//
+// Groups are evaluated independently: a group with a false condition is
+// false, and a group otherwise reading a missing field is unknown, which
+// does not match. ok is false when every group is unknown, so nothing can
+// be said about the snapshot.
+func (e Expr) eval(values map[string]float64, hysteresis float64) (result, ok bool) {
+	for _, g := range e {
+		match, known := true, true
+		for _, c := range g {
+			v, present := values[c.Field]
+			switch {
+			case !present:
+				known = false
+			case !compare(c.Op, v, c.Threshold, hysteresis*math.Abs(c.Threshold)):
+				match = false
+			}
+		}
+		if match && known {
+			return true, true
+		}
+		if !match {
+			ok = true
+		}
+	}
+	return false, ok
+}
+
+func validOp(op string) bool {
+	switch op {
+	case ">", ">=", "<", "<=", "==", "!=":
+		return true
+	}
+	return false
+}
+
+func compare(op string, v, threshold, slack float64) bool {
+	switch op {
+	case ">":
+		return v > threshold-slack
+	case ">=":
+		return v >= threshold-slack
+	case "<":
+		return v < threshold+slack
+	case "<=":
+		return v <= threshold+slack
+	case "==":
+		return v == threshold
+	case "!=":
+		return v != threshold
+	}
+	return false
+}
+
+func parseNumber(s string) (float64, error) {
+	s = strings.ReplaceAll(strings.TrimPrefix(s, "$"), "_", "")
+	scale := 1.0
+	switch {
+	case strings.HasSuffix(s, "k"), strings.HasSuffix(s, "K"):
+		scale = 1e3
+	case strings.HasSuffix(s, "m"), strings.HasSuffix(s, "M"):
+		scale = 1e6
+	case strings.HasSuffix(s, "b"), strings.HasSuffix(s, "B"):
+		scale = 1e9
+	}
+	if scale != 1 {
+		s = s[:len(s)-1]
+	}
+	s = strings.TrimSuffix(s, "%")
+	v, err := strconv.ParseFloat(s, 64)
+	return v * scale, err
+}
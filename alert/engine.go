@@ -0,0 +1,169 @@
+// Package alert evaluates declarative rules against token and market
+// snapshots and delivers the resulting alerts to sinks.
+//
+// Rules are written in a small condition language (see Parse), loaded from
+// JSON or built in code:
+//
+//	engine, err := alert.NewEngine(&alert.RuleSet{Rules: []alert.Rule{
+//		{ID: "pump", When: "priceChange5minPercentage > 20", Cooldown: alert.Duration(15 * time.Minute)},
+//		{ID: "rug", When: "liquidityUSD < $10k", Hysteresis: 0.1},
+//		{ID: "whales", When: "top10HoldingsPercentage > 50 and holdersCount < 500"},
+//	}}, &alert.Options{Sinks: []alert.Sink{alert.LogSink{}}})
+//
+// Snapshots can come from polling (see Poll) or from any streaming feed;
+// evaluation only depends on the snapshots and their times, so recorded
+// snapshots replay deterministically.
+package alert
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Alert is a rule firing for one snapshot key.
+type Alert struct {
+	Rule    string             `json:"rule"`
+	Key     string             `json:"key"`
+	Time    time.Time          `json:"time"`
+	Values  map[string]float64 `json:"values"` // the fields read by the rule
+	Message string             `json:"message"`
+}
+
+// Options tune an Engine.
+type Options struct {
+	Sinks []Sink
+	// OnSinkError is called when a sink fails; by default failures are
+	// ignored so one broken sink does not block the others.
+	OnSinkError func(Sink, Alert, error)
+}
+
+type compiled struct {
+	Rule
+	expr Expr
+}
+
+type state struct {
+	active bool
+	last   time.Time // last alert
+}
+
+type stateKey struct {
+	rule, key string
+}
+
+// Engine evaluates a rule set. It is safe for concurrent use.
+type Engine struct {
+	rules       []compiled
+	sinks       []Sink
+	onSinkError func(Sink, Alert, error)
+
+	mu    sync.Mutex
+	state map[stateKey]*state
+}
+
+// NewEngine compiles rs.
+func NewEngine(rs *RuleSet, opts *Options) (*Engine, error) {
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	e := &Engine{sinks: o.Sinks, onSinkError: o.OnSinkError, state: map[stateKey]*state{}}
+	for _, r := range rs.Rules {
+		expr, _ := Parse(r.When)
+		e.rules = append(e.rules, compiled{Rule: r, expr: expr})
+	}
+	return e, nil
+}
+
+// Evaluate checks every rule against s, delivers the alerts to the sinks and
+// returns them. A rule fires when its condition is true and it is armed and
+// out of its cooldown; it then stays silent until the condition clears by its
+// hysteresis. A group of conditions reading a field the snapshot lacks does
+// not match; a rule whose groups all lack a field keeps its state.
+func (e *Engine) Evaluate(ctx context.Context, s Snapshot) []Alert {
+	alerts := e.evaluate(s)
+	for _, a := range alerts {
+		for _, sink := range e.sinks {
+			if err := sink.Notify(ctx, a); err != nil && e.onSinkError != nil {
+				e.onSinkError(sink, a, err)
+			}
+		}
+	}
+	return alerts
+}
+
+func (e *Engine) evaluate(s Snapshot) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var alerts []Alert
+	for _, r := range e.rules {
+		k := stateKey{r.ID, s.Key}
+		st := e.state[k]
+		if st == nil {
+			st = &state{}
+			e.state[k] = st
+		}
+
+		hysteresis := 0.0
+		if st.active {
+			hysteresis = r.Hysteresis
+		}
+		hit, ok := r.expr.eval(s.Values, hysteresis)
+		if !ok {
+			continue
+		}
+		if !hit {
+			st.active = false
+			continue
+		}
+		if st.active {
+			continue
+		}
+		if !st.last.IsZero() && s.Time.Sub(st.last) < time.Duration(r.Cooldown) {
+			// Still cooling down: stay armed so the rule fires once the
+			// cooldown is over if the condition still holds.
+			continue
+		}
+		st.active = true
+		st.last = s.Time
+
+		values := make(map[string]float64, len(r.expr.Fields()))
+		for _, f := range r.expr.Fields() {
+			values[f] = s.Values[f]
+		}
+		alerts = append(alerts, Alert{
+			Rule:    r.ID,
+			Key:     s.Key,
+			Time:    s.Time,
+			Values:  values,
+			Message: r.message(r.expr, s.Key, s.Values),
+		})
+	}
+	return alerts
+}
+
+// Run evaluates the snapshots of feed until it is closed or ctx is done.
+func (e *Engine) Run(ctx context.Context, feed <-chan Snapshot) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s, ok := <-feed:
+			if !ok {
+				return nil
+			}
+			e.Evaluate(ctx, s)
+		}
+	}
+}
+
+// Reset forgets the state of every rule, re-arming them all.
+func (e *Engine) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.state = map[stateKey]*state{}
+}
@@ -0,0 +1,154 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// fixture is a recorded snapshot feed with the rules replayed over it and
+// the alerts they must raise, in order.
+type fixture struct {
+	Rules     []Rule     `json:"rules"`
+	Snapshots []Snapshot `json:"snapshots"`
+	Alerts    []struct {
+		Rule string    `json:"rule"`
+		Key  string    `json:"key"`
+		Time time.Time `json:"time"`
+	} `json:"alerts"`
+}
+
+func TestEngineFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "engine", "*.json"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("no fixtures: %v", err)
+	}
+	for _, path := range paths {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".json"), func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var f fixture
+			if err := json.Unmarshal(data, &f); err != nil {
+				t.Fatal(err)
+			}
+			engine, err := NewEngine(&RuleSet{Rules: f.Rules}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []Alert
+			for _, s := range f.Snapshots {
+				got = append(got, engine.Evaluate(context.Background(), s)...)
+			}
+			if len(got) != len(f.Alerts) {
+				t.Fatalf("got %d alerts, want %d: %v", len(got), len(f.Alerts), got)
+			}
+			for i, want := range f.Alerts {
+				a := got[i]
+				if a.Rule != want.Rule || a.Key != want.Key || !a.Time.Equal(want.Time) {
+					t.Errorf("alert %d = %s %s %s, want %s %s %s", i,
+						a.Rule, a.Key, a.Time.Format(time.TimeOnly),
+						want.Rule, want.Key, want.Time.Format(time.TimeOnly))
+				}
+			}
+		})
+	}
+}
+
+func TestEvalGroups(t *testing.T) {
+	tests := []struct {
+		when       string
+		values     map[string]float64
+		hysteresis float64
+		result, ok bool
+	}{
+		{"a < 10 or b > 50", map[string]float64{"a": 5}, 0, true, true},
+		{"a < 10 or b > 50", map[string]float64{"b": 60}, 0, true, true},
+		{"a < 10 or b > 50", map[string]float64{"a": 20}, 0, false, true},
+		{"a < 10 or b > 50", map[string]float64{}, 0, false, false},
+		{"a < 10 and b > 50", map[string]float64{"a": 5}, 0, false, false},
+		{"a < 10 and b > 50", map[string]float64{"a": 20}, 0, false, true},
+		{"a < 10000", map[string]float64{"a": 10900}, 0.1, true, true},
+		{"a < 10000", map[string]float64{"a": 11100}, 0.1, false, true},
+		{"a > 20 and b < 1000", map[string]float64{"a": 19, "b": 1090}, 0.1, true, true},
+		{"a > 0", map[string]float64{"a": 0}, 0.5, false, true},
+		{"a == 3", map[string]float64{"a": 3.1}, 0.5, false, true},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.when)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, ok := expr.eval(tt.values, tt.hysteresis)
+		if result != tt.result || ok != tt.ok {
+			t.Errorf("%q on %v with hysteresis %v = %v, %v; want %v, %v",
+				tt.when, tt.values, tt.hysteresis, result, ok, tt.result, tt.ok)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"liquidityUSD < $10k", "liquidityUSD < 10000"},
+		{"a > 1_000 and b <= 2.5m OR c != 1b", "a > 1000 and b <= 2500000 or c != 1000000000"},
+		{"top10HoldingsPercentage >= 50%", "top10HoldingsPercentage >= 50"},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("Parse(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+	for _, in := range []string{"", "a >", "a ~ 1", "a > x", "a > 1 and", "a > 1 xor b < 2"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded", in)
+		}
+	}
+}
+
+func TestTokenSnapshot(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "token_details.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp v2.TokenDetailsResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := TokenSnapshot(&resp.Data, at)
+
+	if want := "ethereum:0x6982508145454ce325ddbe47a25d4ec3d2311933"; s.Key != want {
+		t.Errorf("key = %q, want %q", s.Key, want)
+	}
+	for field, want := range map[string]float64{
+		"liquidityUSD":            41250000.5,
+		"holdersCount":            478213,
+		"top10HoldingsPercentage": 41.7,
+		"bonded":                  1,
+		"decimals":                18,
+	} {
+		if got, ok := s.Values[field]; !ok || got != want {
+			t.Errorf("%s = %v (present %v), want %v", field, got, ok, want)
+		}
+	}
+	for _, field := range []string{"symbol", "exchange", "exchange.name", "bondedAt"} {
+		if _, ok := s.Values[field]; ok {
+			t.Errorf("%s should not be a snapshot value", field)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package alert
+
+import (
+	"context"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/clock"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Poll fetches the token details of every token each interval and sends the
+// snapshots on feed, until ctx is done. Snapshots are dated with clk, which
+// defaults to clock.System. Failed fetches are skipped and retried on the
+// next round.
+func Poll(ctx context.Context, client v2.HTTPClient, clk clock.Clock, interval time.Duration, tokens []v2.TokenDetailsRequest, feed chan<- Snapshot) error {
+	if clk == nil {
+		clk = clock.System
+	}
+	for {
+		for i := range tokens {
+			resp, err := v2.GetTokenDetails(ctx, client, &tokens[i])
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				continue
+			}
+			s := TokenSnapshot(&resp.Data, clk.Now())
+			if resp.Data.Address == "" {
+				s.Key = Key(tokens[i].Blockchain, tokens[i].Address)
+			}
+			select {
+			case feed <- s:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clk.After(interval):
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package alert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is a time.Duration read from JSON as a string such as "5m".
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"5m\": %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Rule raises an alert when its condition becomes true for a snapshot key.
+//
+// Cooldown is the minimum time between two alerts of the rule for the same
+// key. Hysteresis keeps a fired rule active until its condition is false by
+// that fraction of each threshold: "liquidityUSD < 10000" with a hysteresis
+// of 0.1 fires below $10k and re-arms only above $11k, so a value hovering
+// around the threshold alerts once. Being relative, one hysteresis suits
+// every condition of a rule; conditions on a zero threshold have none.
+type Rule struct {
+	ID         string   `json:"id"`
+	When       string   `json:"when"` // see Parse
+	Cooldown   Duration `json:"cooldown,omitempty"`
+	Hysteresis float64  `json:"hysteresis,omitempty"`
+	Message    string   `json:"message,omitempty"` // {key} and {field} of any field in When are substituted
+}
+
+// RuleSet is the declarative configuration of an Engine.
+type RuleSet struct {
+	Rules []Rule `json:"rules"`
+}
+
+// ParseRuleSet reads a JSON rule set.
+func ParseRuleSet(r io.Reader) (*RuleSet, error) {
+	var rs RuleSet
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&rs); err != nil {
+		return nil, fmt.Errorf("alert: parsing rule set: %w", err)
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// Validate reports the first malformed rule. Conditions may only name the
+// numeric and boolean fields of v2.Token and v2.Market, as found in the
+// snapshots of TokenSnapshot and MarketSnapshot; any other name would never
+// have a value and the rule would never fire.
+func (rs *RuleSet) Validate() error {
+	seen := map[string]bool{}
+	for _, r := range rs.Rules {
+		switch {
+		case r.ID == "":
+			return fmt.Errorf("alert: rule %q has no id", r.When)
+		case seen[r.ID]:
+			return fmt.Errorf("alert: duplicate rule %q", r.ID)
+		case r.Cooldown < 0 || r.Hysteresis < 0:
+			return fmt.Errorf("alert: rule %q: negative cooldown or hysteresis", r.ID)
+		}
+		expr, err := Parse(r.When)
+		if err != nil {
+			return fmt.Errorf("alert: rule %q: %w", r.ID, err)
+		}
+		for _, f := range expr.Fields() {
+			if !snapshotFields[f] {
+				return fmt.Errorf("alert: rule %q: unknown field %q", r.ID, f)
+			}
+		}
+		seen[r.ID] = true
+	}
+	return nil
+}
+
+// message renders the rule message for values, or a default one listing
+// the fields of the condition.
+func (r *Rule) message(expr Expr, key string, values map[string]float64) string {
+	if r.Message == "" {
+		parts := []string{r.ID, key + ":"}
+		for _, f := range expr.Fields() {
+			parts = append(parts, f+"="+strconv.FormatFloat(values[f], 'f', -1, 64))
+		}
+		return strings.Join(parts, " ")
+	}
+	pairs := []string{"{key}", key}
+	for _, f := range expr.Fields() {
+		pairs = append(pairs, "{"+f+"}", strconv.FormatFloat(values[f], 'f', -1, 64))
+	}
+	return strings.NewReplacer(pairs...).Replace(r.Message)
+}
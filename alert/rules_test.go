@@ -0,0 +1,35 @@
+package alert
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRuleSet(t *testing.T) {
+	tests := []struct {
+		rules string
+		err   string // empty when valid
+	}{
+		{`{"rules": [{"id": "pump", "when": "priceChange5minPercentage > 20", "cooldown": "15m"}]}`, ""},
+		{`{"rules": [{"id": "pool", "when": "liquidityUSD < $10k or volume24hUSD < 1000"}]}`, ""},
+		{`{"rules": [{"id": "bonded", "when": "bonded == 1"}]}`, ""},
+		{`{"rules": [{"id": "typo", "when": "priceChange5mPercentage > 20"}]}`, `unknown field "priceChange5mPercentage"`},
+		{`{"rules": [{"id": "text", "when": "liquidityUSD < 10 and symbol == 1"}]}`, `unknown field "symbol"`},
+		{`{"rules": [{"id": "nested", "when": "exchange.name > 0"}]}`, "unknown field"},
+		{`{"rules": [{"when": "liquidityUSD < 10"}]}`, "has no id"},
+		{`{"rules": [{"id": "a", "when": "liquidityUSD < 10"}, {"id": "a", "when": "liquidityUSD < 20"}]}`, "duplicate rule"},
+		{`{"rules": [{"id": "a", "when": "liquidityUSD < 10", "hysteresis": -0.1}]}`, "negative"},
+		{`{"rules": [{"id": "a", "when": "liquidityUSD <"}]}`, `rule "a"`},
+		{`{"rules": [{"id": "a", "when": "liquidityUSD < 10", "cooldown": 5}]}`, "duration"},
+		{`{"rules": [{"id": "a", "when": "liquidityUSD < 10", "severity": "high"}]}`, "unknown field"},
+	}
+	for _, tt := range tests {
+		_, err := ParseRuleSet(strings.NewReader(tt.rules))
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: %v", tt.rules, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: error %v, want one containing %q", tt.rules, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+)
+
+// Sink delivers alerts.
+type Sink interface {
+	Notify(ctx context.Context, a Alert) error
+}
+
+// SinkFunc adapts a function to Sink.
+type SinkFunc func(ctx context.Context, a Alert) error
+
+func (f SinkFunc) Notify(ctx context.Context, a Alert) error {
+	return f(ctx, a)
+}
+
+// ChanSink sends alerts on a channel, blocking until the receiver is ready
+// or the context is done.
+type ChanSink chan<- Alert
+
+func (c ChanSink) Notify(ctx context.Context, a Alert) error {
+	select {
+	case c <- a:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// LogSink writes alerts to Logger, or to the standard logger when nil.
+type LogSink struct {
+	Logger *log.Logger
+}
+
+func (s LogSink) Notify(ctx context.Context, a Alert) error {
+	logger := s.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+	logger.Printf("alert %s: %s", a.Rule, a.Message)
+	return nil
+}
+
+// WebhookSink posts each alert as JSON to URL.
+type WebhookSink struct {
+	URL    string
+	Client *http.Client // default http.DefaultClient
+	Header http.Header  // extra headers, e.g. authorization
+}
+
+func (s WebhookSink) Notify(ctx context.Context, a Alert) error {
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.Header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("alert: webhook answered %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package alert
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	"github.com/zomvs/mobula-go-sdk/internal/fields"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Snapshot is the state of one token or pool at one time. Values are keyed
+// by the JSON field names of the model they were read from; booleans read
+// as 1 or 0.
+type Snapshot struct {
+	Key    string // identifies the token or pool; rule state is kept per key
+	Time   time.Time
+	Values map[string]float64
+}
+
+// snapshotFields are the value names TokenSnapshot and MarketSnapshot
+// produce: the top-level numeric and boolean fields of v2.Token and
+// v2.Market.
+var snapshotFields = func() map[string]bool {
+	names := map[string]bool{}
+	for _, t := range []reflect.Type{reflect.TypeOf(v2.Token{}), reflect.TypeOf(v2.Market{})} {
+		for _, f := range fields.Of(t) {
+			if !f.Nested() && fields.Numeric(f.Type) {
+				names[f.Name] = true
+			}
+		}
+	}
+	return names
+}()
+
+// TokenSnapshot flattens a token, for instance from GetTokenDetails, into a
+// snapshot keyed by blockchain and address.
+func TokenSnapshot(t *v2.Token, at time.Time) Snapshot {
+	return Snapshot{Key: Key(t.Blockchain, t.Address), Time: at, Values: fields.Numbers(t)}
+}
+
+// MarketSnapshot flattens a market, for instance from GetMarketDetails, into
+// a snapshot keyed by blockchain and pool address.
+func MarketSnapshot(m *v2.Market, at time.Time) Snapshot {
+	return Snapshot{Key: Key(m.Blockchain, m.Address), Time: at, Values: fields.Numbers(m)}
+}
+
+// Key is the snapshot key of a token or pool: the lowercase blockchain and
+// the address, lowercased for EVM addresses only.
+func Key(blockchain, addr string) string {
+	return address.Key(blockchain, addr)
+}
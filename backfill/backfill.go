@@ -0,0 +1,285 @@
+// Package backfill pulls historical data for many tokens as a resumable job.
+//
+// The job is split into (token, time range) tasks that run with bounded
+// concurrency. Each finished task is written to a Sink and then recorded in a
+// Checkpoint, so a job restarted after a crash skips the tasks already done:
+//
+//	cp, err := backfill.OpenFileCheckpoint("backfill.ckpt")
+//	out, err := os.OpenFile("candles.ndjson", os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+//	r := backfill.NewRunner(client, backfill.OHLCV(v2.OHLCVPeriod1H),
+//		backfill.NewNDJSONSink[[]v2.OHLCVCandle](out), &backfill.Options{
+//			Concurrency: 8,
+//			Checkpoint:  cp,
+//			OnProgress:  func(p backfill.Progress) { log.Println(p) },
+//		})
+//	tasks := backfill.Plan(tokens, time.Now().AddDate(-1, 0, 0), time.Now(), 30*24*time.Hour)
+//	report, err := r.Run(ctx, tasks)
+//
+// A task whose output was written but not yet checkpointed when the process
+// died runs again on resume, so sinks see every task at least once.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Token identifies a token on a blockchain.
+type Token struct {
+	Address    string `json:"address"`
+	Blockchain string `json:"blockchain"`
+}
+
+// Task is one token over one time range, From inclusive and To exclusive.
+type Task struct {
+	Token Token     `json:"token"`
+	From  time.Time `json:"from"`
+	To    time.Time `json:"to"`
+}
+
+// ID identifies the task in checkpoints. It only depends on the token and the
+// range, so planning the same job again yields the same IDs.
+func (t Task) ID() string {
+	return fmt.Sprintf("%s:%d-%d", address.Key(t.Token.Blockchain, t.Token.Address), t.From.UnixMilli(), t.To.UnixMilli())
+}
+
+// Plan splits [from, to) into chunks of at most chunk for every token.
+func Plan(tokens []Token, from, to time.Time, chunk time.Duration) []Task {
+	if chunk <= 0 {
+		chunk = to.Sub(from)
+	}
+	var tasks []Task
+	for _, tok := range tokens {
+		for start := from; start.Before(to); start = start.Add(chunk) {
+			end := start.Add(chunk)
+			if end.After(to) {
+				end = to
+			}
+			tasks = append(tasks, Task{Token: tok, From: start, To: end})
+		}
+	}
+	return tasks
+}
+
+// Fetch retrieves the data of one task. It is called again when it fails
+// with a retryable error, so it should not have side effects.
+type Fetch[T any] func(ctx context.Context, client v2.HTTPClient, t Task) (T, error)
+
+// Options tune a Runner.
+type Options struct {
+	Concurrency int        // tasks run at once, default 4
+	MaxRetries  int        // retries of a failed fetch, default 5
+	Checkpoint  Checkpoint // default a MemoryCheckpoint, which does not survive restarts
+	// OnProgress is called after every task. Calls are serialized.
+	OnProgress func(Progress)
+}
+
+// Progress is a snapshot of a running job.
+type Progress struct {
+	Total   int           // tasks in the job
+	Skipped int           // already done at start, from the checkpoint
+	Done    int           // finished in this run
+	Failed  int           // gave up after retries
+	Elapsed time.Duration // since Run started
+	ETA     time.Duration // estimated time left, zero until a task finished
+}
+
+// Remaining is the number of tasks neither done nor failed.
+func (p Progress) Remaining() int {
+	return p.Total - p.Skipped - p.Done - p.Failed
+}
+
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d done, %d failed, eta %s",
+		p.Skipped+p.Done, p.Total, p.Failed, p.ETA.Round(time.Second))
+}
+
+// Failure is a task that failed on every attempt.
+type Failure struct {
+	Task Task
+	Err  error
+}
+
+// Report summarizes a run. Failed tasks are not checkpointed and run again
+// on the next Run.
+type Report struct {
+	Progress
+	Failures []Failure
+}
+
+// Runner executes backfill tasks. Create one with NewRunner.
+type Runner[T any] struct {
+	client v2.HTTPClient
+	fetch  Fetch[T]
+	sink   Sink[T]
+	opts   Options
+}
+
+// NewRunner creates a runner fetching with fetch and writing to sink.
+func NewRunner[T any](client v2.HTTPClient, fetch Fetch[T], sink Sink[T], opts *Options) *Runner[T] {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 5
+	}
+	if o.Checkpoint == nil {
+		o.Checkpoint = NewMemoryCheckpoint()
+	}
+	return &Runner[T]{client: client, fetch: fetch, sink: sink, opts: o}
+}
+
+// Run executes the tasks not yet in the checkpoint. Tasks failing after
+// their retries are listed in the report; Run itself only fails when ctx is
+// done or the sink or checkpoint cannot be written, since continuing would
+// lose data.
+func (r *Runner[T]) Run(ctx context.Context, tasks []Task) (*Report, error) {
+	start := time.Now()
+	report := &Report{Progress: Progress{Total: len(tasks)}}
+
+	var pending []Task
+	for _, t := range tasks {
+		done, err := r.opts.Checkpoint.Done(ctx, t.ID())
+		if err != nil {
+			return report, fmt.Errorf("backfill: reading checkpoint: %w", err)
+		}
+		if done {
+			report.Skipped++
+		} else {
+			pending = append(pending, t)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu    sync.Mutex
+		fatal error
+	)
+	finish := func(t Task, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err == nil:
+			report.Done++
+		case fatal != nil || ctx.Err() != nil:
+			return
+		case errors.As(err, new(fatalError)):
+			fatal = err
+			cancel()
+			return
+		default:
+			report.Failed++
+			report.Failures = append(report.Failures, Failure{Task: t, Err: err})
+		}
+		report.Elapsed = time.Since(start)
+		if finished := report.Done + report.Failed; finished > 0 {
+			report.ETA = report.Elapsed / time.Duration(finished) * time.Duration(report.Remaining())
+		}
+		if r.opts.OnProgress != nil {
+			r.opts.OnProgress(report.Progress)
+		}
+	}
+
+	queue := make(chan Task)
+	var wg sync.WaitGroup
+	for i := 0; i < min(r.opts.Concurrency, len(pending)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range queue {
+				finish(t, r.run(ctx, t))
+			}
+		}()
+	}
+feed:
+	for _, t := range pending {
+		select {
+		case <-ctx.Done():
+			break feed
+		case queue <- t:
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	report.Elapsed = time.Since(start)
+	if fatal != nil {
+		return report, fatal
+	}
+	return report, ctx.Err()
+}
+
+// fatalError marks sink and checkpoint errors, which stop the run.
+type fatalError struct{ err error }
+
+func (e fatalError) Error() string { return e.err.Error() }
+func (e fatalError) Unwrap() error { return e.err }
+
+func (r *Runner[T]) run(ctx context.Context, t Task) error {
+	var data T
+	err := r.call(ctx, func() error {
+		var err error
+		data, err = r.fetch(ctx, r.client, t)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.sink.Write(ctx, t, data); err != nil {
+		return fatalError{fmt.Errorf("backfill: writing %s: %w", t.ID(), err)}
+	}
+	if err := r.opts.Checkpoint.Mark(ctx, t.ID()); err != nil {
+		return fatalError{fmt.Errorf("backfill: checkpointing %s: %w", t.ID(), err)}
+	}
+	return nil
+}
+
+// call runs fn, retrying rate-limited, server and network errors with
+// exponential backoff.
+func (r *Runner[T]) call(ctx context.Context, fn func() error) error {
+	backoff := time.Second
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= r.opts.MaxRetries || !retryable(ctx, err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, time.Minute)
+	}
+}
+
+// retryable reports whether err may succeed on another attempt: a 429, a
+// server error or a network error. Other failures, such as a response that
+// does not decode, would fail the same way again.
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	var apiErr *mobula.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	// A body cut short by the connection surfaces as io.ErrUnexpectedEOF.
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,302 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// clientFunc adapts a function to a v2.HTTPClient.
+type clientFunc func(ctx context.Context, path string, params url.Values, result interface{}) error
+
+func (f clientFunc) Get(ctx context.Context, path string, params url.Values, result interface{}) error {
+	return f(ctx, path, params, result)
+}
+
+var (
+	pepe  = Token{Address: "0x6982508145454ce325ddbe47a25d4ec3d2311933", Blockchain: "ethereum"}
+	start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// tradesClient serves one trade per task, failing once ctx is done.
+func tradesClient() v2.HTTPClient {
+	return clientFunc(func(ctx context.Context, _ string, params url.Values, result interface{}) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		from, _ := strconv.ParseInt(params.Get("from"), 10, 64)
+		result.(*v2.TokenTradesResponse).Data = []v2.TokenTrade{{ID: params.Get("from"), Date: from}}
+		return nil
+	})
+}
+
+func TestResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backfill.ckpt")
+	tasks := Plan([]Token{pepe}, start, start.Add(6*time.Hour), time.Hour)
+
+	cp, err := OpenFileCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var first []Task
+	sink := SinkFunc[[]v2.TokenTrade](func(_ context.Context, task Task, _ []v2.TokenTrade) error {
+		first = append(first, task)
+		if len(first) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	report, err := NewRunner(tradesClient(), Trades(), sink, &Options{Concurrency: 1, Checkpoint: cp}).Run(ctx, tasks)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled run returned %v", err)
+	}
+	if report.Done != 2 || report.Failed != 0 {
+		t.Errorf("cancelled run %+v, want 2 done and none failed", report.Progress)
+	}
+	cp.Close()
+
+	// A restart reads the same file again.
+	cp, err = OpenFileCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cp.Close()
+	var second []Task
+	sink = func(_ context.Context, task Task, _ []v2.TokenTrade) error {
+		second = append(second, task)
+		return nil
+	}
+	report, err = NewRunner(tradesClient(), Trades(), sink, &Options{Concurrency: 1, Checkpoint: cp}).Run(context.Background(), tasks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Skipped != 2 || report.Done != 4 || report.Remaining() != 0 {
+		t.Errorf("resumed run %+v, want 2 skipped and 4 done", report.Progress)
+	}
+	if want := tasks[2:]; !reflect.DeepEqual(second, want) {
+		t.Errorf("resumed run wrote %v, want %v", second, want)
+	}
+}
+
+func TestFileCheckpointTornLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backfill.ckpt")
+	if err := os.WriteFile(path, []byte("a\nb\nc-cut-sh"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cp, err := OpenFileCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for id, want := range map[string]bool{"a": true, "b": true, "c-cut-sh": false, "c": false} {
+		if done, _ := cp.Done(ctx, id); done != want {
+			t.Errorf("Done(%q) = %v, want %v", id, done, want)
+		}
+	}
+	if err := cp.Mark(ctx, "c"); err != nil {
+		t.Fatal(err)
+	}
+	cp.Close()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "a\nb\nc\n"; string(data) != want {
+		t.Errorf("checkpoint file %q, want %q", data, want)
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	decodeErr := fmt.Errorf("failed to unmarshal response: %w", json.Unmarshal([]byte("{"), new(v2.TokenTradesResponse)))
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rate limited", &mobula.APIError{StatusCode: 429}, true},
+		{"server error", &mobula.APIError{StatusCode: 500}, true},
+		{"unavailable", fmt.Errorf("wrapped: %w", &mobula.APIError{StatusCode: 503}), true},
+		{"bad request", &mobula.APIError{StatusCode: 400}, false},
+		{"not found", &mobula.APIError{StatusCode: 404}, false},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"body cut short", fmt.Errorf("reading body: %w", io.ErrUnexpectedEOF), true},
+		{"decode", decodeErr, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryable(context.Background(), tt.err); got != tt.want {
+				t.Errorf("retryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if retryable(ctx, &mobula.APIError{StatusCode: 429}) {
+		t.Error("retryable once ctx is done")
+	}
+}
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name     string
+		errs     []error // returned by successive calls, then success
+		calls    int
+		failures int
+	}{
+		{"rate limited then served", []error{&mobula.APIError{StatusCode: 429}}, 2, 0},
+		{"decode error not retried", []error{errors.New("failed to unmarshal response: unexpected end of JSON input")}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			client := clientFunc(func(context.Context, string, url.Values, interface{}) error {
+				calls++
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+				return nil
+			})
+			sink := SinkFunc[[]v2.TokenTrade](func(context.Context, Task, []v2.TokenTrade) error { return nil })
+			tasks := Plan([]Token{pepe}, start, start.Add(time.Hour), 0)
+			report, err := NewRunner(client, Trades(), sink, nil).Run(context.Background(), tasks)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if calls != tt.calls || len(report.Failures) != tt.failures {
+				t.Errorf("%d calls and failures %v, want %d calls and %d failures", calls, report.Failures, tt.calls, tt.failures)
+			}
+		})
+	}
+}
+
+func TestOHLCVPaging(t *testing.T) {
+	var froms []int64
+	client := clientFunc(func(_ context.Context, _ string, params url.Values, result interface{}) error {
+		from, _ := strconv.ParseInt(params.Get("from"), 10, 64)
+		to, _ := strconv.ParseInt(params.Get("to"), 10, 64)
+		amount, _ := strconv.Atoi(params.Get("amount"))
+		froms = append(froms, from)
+		// One candle a minute from the first minute at or after from, To
+		// included.
+		minute := time.Minute.Milliseconds()
+		var candles []v2.OHLCVCandle
+		for at := (from + minute - 1) / minute * minute; at <= to && len(candles) < amount; at += minute {
+			candles = append(candles, v2.OHLCVCandle{Time: at})
+		}
+		result.(*v2.TokenOHLCVHistoryResponse).Data = candles
+		return nil
+	})
+	task := Task{Token: pepe, From: start, To: start.Add(4500 * time.Minute)}
+	candles, err := OHLCV(v2.OHLCVPeriod1Min)(context.Background(), client, task)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 4500 {
+		t.Fatalf("%d candles, want 4500", len(candles))
+	}
+	for i, c := range candles {
+		if want := start.Add(time.Duration(i) * time.Minute).UnixMilli(); c.Time != want {
+			t.Fatalf("candle %d at %d, want %d", i, c.Time, want)
+		}
+	}
+	// Each page starts just after the last candle received.
+	want := []int64{
+		start.UnixMilli(),
+		start.Add((maxCandles-1)*time.Minute).UnixMilli() + 1,
+		start.Add((2*maxCandles-1)*time.Minute).UnixMilli() + 1,
+	}
+	if !reflect.DeepEqual(froms, want) {
+		t.Errorf("pages from %v, want %v", froms, want)
+	}
+}
+
+func TestTradesPaging(t *testing.T) {
+	tests := []struct {
+		total   int
+		offsets []int
+	}{
+		{500, []int{0}},
+		{2000, []int{0, 1000, 2000}},
+		{2500, []int{0, 1000, 2000}},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.total), func(t *testing.T) {
+			var offsets []int
+			client := clientFunc(func(_ context.Context, _ string, params url.Values, result interface{}) error {
+				offset, _ := strconv.Atoi(params.Get("offset")) // absent when 0
+				limit, _ := strconv.Atoi(params.Get("limit"))
+				offsets = append(offsets, offset)
+				var trades []v2.TokenTrade
+				for i := offset; i < min(offset+limit, tt.total); i++ {
+					trades = append(trades, v2.TokenTrade{ID: strconv.Itoa(i), Date: start.UnixMilli()})
+				}
+				result.(*v2.TokenTradesResponse).Data = trades
+				return nil
+			})
+			task := Task{Token: pepe, From: start, To: start.Add(time.Hour)}
+			trades, err := Trades()(context.Background(), client, task)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(trades) != tt.total || trades[len(trades)-1].ID != strconv.Itoa(tt.total-1) {
+				t.Errorf("%d trades, want %d", len(trades), tt.total)
+			}
+			if !reflect.DeepEqual(offsets, tt.offsets) {
+				t.Errorf("offsets %v, want %v", offsets, tt.offsets)
+			}
+		})
+	}
+}
+
+func TestSinkError(t *testing.T) {
+	tasks := Plan([]Token{pepe}, start, start.Add(3*time.Hour), time.Hour)
+	diskFull := errors.New("no space left on device")
+	var mu sync.Mutex
+	var written []Task
+	sink := SinkFunc[[]v2.TokenTrade](func(_ context.Context, task Task, _ []v2.TokenTrade) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if task == tasks[1] {
+			return diskFull
+		}
+		written = append(written, task)
+		return nil
+	})
+	cp := NewMemoryCheckpoint()
+	report, err := NewRunner(tradesClient(), Trades(), sink, &Options{Concurrency: 1, Checkpoint: cp}).Run(context.Background(), tasks)
+	if !errors.Is(err, diskFull) {
+		t.Fatalf("run returned %v, want the sink error", err)
+	}
+	if len(report.Failures) != 0 {
+		t.Errorf("sink error reported as task failures %v", report.Failures)
+	}
+	ctx := context.Background()
+	if done, _ := cp.Done(ctx, tasks[1].ID()); done {
+		t.Error("task the sink failed to write was checkpointed")
+	}
+	if done, _ := cp.Done(ctx, tasks[0].ID()); !done {
+		t.Error("task written before the error was not checkpointed")
+	}
+	if done, _ := cp.Done(ctx, tasks[2].ID()); done {
+		t.Error("run went on after the sink error")
+	}
+	if !reflect.DeepEqual(written, tasks[:1]) {
+		t.Errorf("sink wrote %v, want %v", written, tasks[:1])
+	}
+}
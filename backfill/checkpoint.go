@@ -0,0 +1,106 @@
+package backfill
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Checkpoint records finished tasks by ID. Implementations backed by a
+// key-value store only need these two calls; both may be called from several
+// goroutines.
+type Checkpoint interface {
+	Done(ctx context.Context, id string) (bool, error)
+	Mark(ctx context.Context, id string) error
+}
+
+// MemoryCheckpoint is an in-process Checkpoint, lost when the process exits.
+type MemoryCheckpoint struct {
+	mu   sync.Mutex
+	done map[string]bool
+}
+
+// NewMemoryCheckpoint creates an empty checkpoint.
+func NewMemoryCheckpoint() *MemoryCheckpoint {
+	return &MemoryCheckpoint{done: map[string]bool{}}
+}
+
+func (c *MemoryCheckpoint) Done(_ context.Context, id string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.done[id], nil
+}
+
+func (c *MemoryCheckpoint) Mark(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.done[id] = true
+	return nil
+}
+
+// FileCheckpoint is a Checkpoint kept in a local file with one task ID per
+// line. Every Mark is synced to disk before it returns.
+type FileCheckpoint struct {
+	MemoryCheckpoint
+	f *os.File
+}
+
+// OpenFileCheckpoint opens or creates the checkpoint file at path and loads
+// the tasks it records. A last line cut short by a crash is removed, so the
+// next Mark starts on a line of its own.
+func OpenFileCheckpoint(path string) (*FileCheckpoint, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("backfill: opening checkpoint: %w", err)
+	}
+	c := &FileCheckpoint{MemoryCheckpoint: MemoryCheckpoint{done: map[string]bool{}}, f: f}
+	r := bufio.NewReader(f)
+	var complete int64 // bytes up to the last newline
+	for {
+		line, err := r.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backfill: reading checkpoint: %w", err)
+		}
+		complete += int64(len(line))
+		if id := line[:len(line)-1]; id != "" {
+			c.done[id] = true
+		}
+	}
+	info, err := f.Stat()
+	if err == nil && info.Size() != complete {
+		err = f.Truncate(complete)
+	}
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("backfill: trimming checkpoint: %w", err)
+	}
+	return c, nil
+}
+
+func (c *FileCheckpoint) Mark(_ context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done[id] {
+		return nil
+	}
+	if _, err := c.f.WriteString(id + "\n"); err != nil {
+		return err
+	}
+	if err := c.f.Sync(); err != nil {
+		return err
+	}
+	c.done[id] = true
+	return nil
+}
+
+// Close closes the checkpoint file.
+func (c *FileCheckpoint) Close() error {
+	return c.f.Close()
+}
@@ -0,0 +1,47 @@
+package backfill
+
+import (
+	"context"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// maxCandles is the most candles the OHLCV history endpoint returns at once.
+const maxCandles = 2000
+
+// OHLCV fetches the candles of a task at period. Ranges longer than one
+// response are paged from the latest candle received, as the endpoint
+// returns candles from the start of the requested range.
+func OHLCV(period v2.OHLCVPeriod) Fetch[[]v2.OHLCVCandle] {
+	return func(ctx context.Context, client v2.HTTPClient, t Task) ([]v2.OHLCVCandle, error) {
+		var candles []v2.OHLCVCandle
+		from, to := t.From.UnixMilli(), t.To.UnixMilli()
+		for from < to {
+			resp, err := v2.GetTokenOHLCVHistory(ctx, client, &v2.TokenOHLCVHistoryRequest{
+				Address:    t.Token.Address,
+				Blockchain: t.Token.Blockchain,
+				From:       from,
+				To:         to,
+				Period:     period,
+				Amount:     maxCandles,
+			})
+			if err != nil {
+				return nil, err
+			}
+			next := from
+			for _, c := range resp.Data {
+				// The range is [From, To); the endpoint may include To.
+				if c.Time < from || c.Time >= to {
+					continue
+				}
+				candles = append(candles, c)
+				next = max(next, c.Time+1)
+			}
+			if len(resp.Data) < maxCandles || next == from {
+				break
+			}
+			from = next
+		}
+		return candles, nil
+	}
+}
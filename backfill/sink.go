@@ -0,0 +1,54 @@
+package backfill
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// Sink receives the data of finished tasks. Write may be called from several
+// goroutines and should return only once the data is durable, since the task
+// is checkpointed right after.
+type Sink[T any] interface {
+	Write(ctx context.Context, t Task, data T) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc[T any] func(ctx context.Context, t Task, data T) error
+
+func (f SinkFunc[T]) Write(ctx context.Context, t Task, data T) error {
+	return f(ctx, t, data)
+}
+
+// Record is one line written by an NDJSONSink.
+type Record[T any] struct {
+	Task Task `json:"task"`
+	Data T    `json:"data"`
+}
+
+// NDJSONSink writes one JSON Record per task to a writer, typically a file
+// opened for appending. If the writer has a Sync method, as *os.File does,
+// it is called after every record.
+type NDJSONSink[T any] struct {
+	mu  sync.Mutex
+	w   io.Writer
+	enc *json.Encoder
+}
+
+// NewNDJSONSink creates a sink writing to w.
+func NewNDJSONSink[T any](w io.Writer) *NDJSONSink[T] {
+	return &NDJSONSink[T]{w: w, enc: json.NewEncoder(w)}
+}
+
+func (s *NDJSONSink[T]) Write(_ context.Context, t Task, data T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.enc.Encode(Record[T]{Task: t, Data: data}); err != nil {
+		return err
+	}
+	if f, ok := s.w.(interface{ Sync() error }); ok {
+		return f.Sync()
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package backfill
+
+import (
+	"context"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// maxTrades is the most trades the token trades endpoint returns at once.
+const maxTrades = 1000
+
+// Trades fetches the trades of a task. Ranges with more trades than one
+// response are paged by offset; several trades can share a block time, so
+// paging from the last date received could skip or repeat some.
+func Trades() Fetch[[]v2.TokenTrade] {
+	return func(ctx context.Context, client v2.HTTPClient, t Task) ([]v2.TokenTrade, error) {
+		var trades []v2.TokenTrade
+		from, to := t.From.UnixMilli(), t.To.UnixMilli()
+		for offset := 0; ; offset += maxTrades {
+			resp, err := v2.GetTokenTrades(ctx, client, &v2.TokenTradesRequest{
+				Address:    t.Token.Address,
+				Blockchain: t.Token.Blockchain,
+				From:       from,
+				To:         to,
+				Limit:      maxTrades,
+				Offset:     offset,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, tr := range resp.Data {
+				// The range is [From, To); the endpoint may include To.
+				if tr.Date >= from && tr.Date < to {
+					trades = append(trades, tr)
+				}
+			}
+			if len(resp.Data) < maxTrades {
+				return trades, nil
+			}
+		}
+	}
+}
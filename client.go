@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/zomvs/mobula-go-sdk/drift"
 	v2 "github.com/zomvs/mobula-go-sdk/v2"
 )
 
@@ -21,9 +23,17 @@ const (
 
 // Client is the main Mobula API client
 type Client struct {
-	baseURL    string
-	apiKey     string
-	httpClient *http.Client
+	baseURL       string
+	apiKey        string
+	httpClient    *http.Client
+	limiter       Limiter
+	onSchemaDrift func(path string, report *drift.Report)
+}
+
+// Limiter paces requests. *rate.Limiter from golang.org/x/time/rate
+// satisfies it.
+type Limiter interface {
+	Wait(ctx context.Context) error
 }
 
 // Config holds the configuration for the Mobula client
@@ -32,6 +42,16 @@ type Config struct {
 	APIKey     string
 	HTTPClient *http.Client
 	Timeout    time.Duration
+
+	// Limiter paces every request of the client, including the retries of
+	// helpers such as backfill and portfolio, when set.
+	Limiter Limiter
+
+	// OnSchemaDrift enables strict decoding. Every response is checked
+	// against the type it decodes into, and unknown fields or type
+	// mismatches are reported here with the request path. Drift never
+	// fails the call: values that do not fit are left at their zero value.
+	OnSchemaDrift func(path string, report *drift.Report)
 }
 
 // NewClient creates a new Mobula API client
@@ -61,9 +81,11 @@ func NewClient(config *Config) *Client {
 	}
 
 	client := &Client{
-		baseURL:    baseURL,
-		apiKey:     config.APIKey,
-		httpClient: httpClient,
+		baseURL:       baseURL,
+		apiKey:        config.APIKey,
+		httpClient:    httpClient,
+		limiter:       config.Limiter,
+		onSchemaDrift: config.OnSchemaDrift,
 	}
 
 	return client
@@ -71,6 +93,12 @@ func NewClient(config *Config) *Client {
 
 // doRequest performs an HTTP request
 func (c *Client) doRequest(ctx context.Context, method, path string, queryParams url.Values, body interface{}) ([]byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	u, err := url.Parse(c.baseURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
@@ -128,9 +156,7 @@ func (c *Client) get(ctx context.Context, path string, queryParams url.Values, r
 	}
 
 	if result != nil {
-		if err := json.Unmarshal(respBody, result); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
-		}
+		return c.decode(path, respBody, result)
 	}
 
 	return nil
@@ -144,10 +170,37 @@ func (c *Client) post(ctx context.Context, path string, body interface{}, result
 	}
 
 	if result != nil {
-		if err := json.Unmarshal(respBody, result); err != nil {
+		return c.decode(path, respBody, result)
+	}
+
+	return nil
+}
+
+// decode unmarshals a response body into result. In strict mode the body is
+// also checked for drift, and type mismatches are reported instead of
+// returned.
+func (c *Client) decode(path string, respBody []byte, result interface{}) error {
+	err := json.Unmarshal(respBody, result)
+	if c.onSchemaDrift == nil {
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return nil
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if err != nil && !errors.As(err, &typeErr) {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	report, driftErr := drift.Check(respBody, result)
+	if driftErr != nil || report.Empty() {
+		if err != nil {
 			return fmt.Errorf("failed to unmarshal response: %w", err)
 		}
+		return nil
 	}
+	c.onSchemaDrift(path, report)
 
 	return nil
 }
@@ -157,6 +210,11 @@ func (c *Client) Get(ctx context.Context, path string, queryParams url.Values, r
 	return c.get(ctx, path, queryParams, result)
 }
 
+// Post performs a POST request (public wrapper for v2 package)
+func (c *Client) Post(ctx context.Context, path string, body interface{}, result interface{}) error {
+	return c.post(ctx, path, body, result)
+}
+
 // ========================
 // Token Security API
 // ========================
@@ -201,3 +259,130 @@ func (c *Client) GetMarketDetails(ctx context.Context, req *v2.MarketDetailsRequ
 func (c *Client) GetTokenMarkets(ctx context.Context, req *v2.TokenMarketsRequest) (*v2.TokenMarketsResponse, error) {
 	return v2.GetTokenMarkets(ctx, c, req)
 }
+
+// ========================
+// Search API
+// ========================
+
+// Search searches assets, tokens and pools by name, symbol or address
+func (c *Client) Search(ctx context.Context, req *v2.SearchRequest) (*v2.SearchResponse, error) {
+	return v2.GetSearch(ctx, c, req)
+}
+
+// ResolveSymbol picks the canonical token for a symbol and reports whether
+// the choice is ambiguous
+func (c *Client) ResolveSymbol(ctx context.Context, symbol string, opts *v2.ResolveOptions) (*v2.Resolution, error) {
+	return v2.ResolveSymbol(ctx, c, symbol, opts)
+}
+
+// ========================
+// Pulse API
+// ========================
+
+// GetPulse retrieves a Pulse discovery feed of trending and newly launched tokens
+func (c *Client) GetPulse(ctx context.Context, req *v2.PulseRequest) (*v2.PulseResponse, error) {
+	return v2.GetPulse(ctx, c, req)
+}
+
+// ========================
+// Market Query API
+// ========================
+
+// GetMarketQuery lists tokens matching server-side filters, sorted and paginated
+func (c *Client) GetMarketQuery(ctx context.Context, req *v2.MarketQueryRequest) (*v2.MarketQueryResponse, error) {
+	return v2.GetMarketQuery(ctx, c, req)
+}
+
+// ========================
+// Swap API
+// ========================
+
+// GetSwapQuote retrieves a swap quote with its route, expected output and fees
+func (c *Client) GetSwapQuote(ctx context.Context, req *v2.SwapQuoteRequest) (*v2.SwapQuoteResponse, error) {
+	return v2.GetSwapQuote(ctx, c, req)
+}
+
+// GetGuardedSwapQuote retrieves a swap quote and refuses it when either token
+// breaks limits on price impact, token fees or pool liquidity
+func (c *Client) GetGuardedSwapQuote(ctx context.Context, req *v2.SwapQuoteRequest, limits v2.QuoteLimits) (*v2.SwapQuoteResponse, error) {
+	return GetGuardedSwapQuote(ctx, c, req, limits)
+}
+
+// BuildSwapTransaction builds the unsigned transaction executing a swap
+func (c *Client) BuildSwapTransaction(ctx context.Context, req *v2.SwapTransactionRequest) (*v2.SwapTransactionResponse, error) {
+	return v2.BuildSwapTransaction(ctx, c, req)
+}
+
+// SendSwapTransaction broadcasts a signed swap transaction
+func (c *Client) SendSwapTransaction(ctx context.Context, req *v2.SwapSendRequest) (*v2.SwapSendResponse, error) {
+	return v2.SendSwapTransaction(ctx, c, req)
+}
+
+// ExecuteSwap builds, optionally simulates, signs and broadcasts a swap
+func (c *Client) ExecuteSwap(ctx context.Context, req *v2.SwapTransactionRequest, signer v2.Signer, opts *v2.ExecuteOptions) (*v2.SwapExecution, error) {
+	return v2.ExecuteSwap(ctx, c, req, signer, opts)
+}
+
+// ========================
+// Token OHLCV History API
+// ========================
+
+// GetTokenOHLCVHistory retrieves price candles for a token over a time range
+func (c *Client) GetTokenOHLCVHistory(ctx context.Context, req *v2.TokenOHLCVHistoryRequest) (*v2.TokenOHLCVHistoryResponse, error) {
+	return v2.GetTokenOHLCVHistory(ctx, c, req)
+}
+
+// ========================
+// Token Trades API
+// ========================
+
+// GetTokenTrades retrieves the swaps of a token over a time range
+func (c *Client) GetTokenTrades(ctx context.Context, req *v2.TokenTradesRequest) (*v2.TokenTradesResponse, error) {
+	return v2.GetTokenTrades(ctx, c, req)
+}
+
+// ========================
+// Wallet Portfolio API
+// ========================
+
+// GetWalletPortfolio retrieves the fungible token positions of a wallet
+func (c *Client) GetWalletPortfolio(ctx context.Context, req *v2.WalletPortfolioRequest) (*v2.WalletPortfolioResponse, error) {
+	return v2.GetWalletPortfolio(ctx, c, req)
+}
+
+// ========================
+// Wallet History API
+// ========================
+
+// GetWalletHistoricalNetWorth retrieves the net worth and PnL of a wallet over time
+func (c *Client) GetWalletHistoricalNetWorth(ctx context.Context, req *v2.WalletHistoryRequest) (*v2.WalletHistoryResponse, error) {
+	return v2.GetWalletHistory(ctx, c, req)
+}
+
+// ========================
+// Wallet Labels API
+// ========================
+
+// GetWalletLabels retrieves the labels and trader classification of wallets
+func (c *Client) GetWalletLabels(ctx context.Context, req *v2.WalletLabelsRequest) (*v2.WalletLabelsResponse, error) {
+	return v2.GetWalletLabels(ctx, c, req)
+}
+
+// ========================
+// NFT API
+// ========================
+
+// GetWalletNFTs retrieves one page of the NFTs held by a wallet
+func (c *Client) GetWalletNFTs(ctx context.Context, req *v2.WalletNFTsRequest) (*v2.WalletNFTsResponse, error) {
+	return v2.GetWalletNFTs(ctx, c, req)
+}
+
+// WalletNFTs returns an iterator over all the NFTs held by a wallet
+func (c *Client) WalletNFTs(req *v2.WalletNFTsRequest) *v2.WalletNFTIterator {
+	return v2.NewWalletNFTIterator(c, req)
+}
+
+// GetNFTCollection retrieves the metadata and market figures of an NFT collection
+func (c *Client) GetNFTCollection(ctx context.Context, req *v2.NFTCollectionRequest) (*v2.NFTCollectionResponse, error) {
+	return v2.GetNFTCollection(ctx, c, req)
+}
@@ -0,0 +1,148 @@
+// Command mobula-drift checks a corpus of saved API responses against the
+// current v2 models and prints every unknown field and type mismatch.
+//
+// The corpus is a directory tree of JSON response bodies, one directory per
+// endpoint named after its v2 path constant:
+//
+//	corpus/TokenDetails/pepe.json
+//	corpus/TokenMarkets/weth.json
+//
+// Single files can be checked with -endpoint:
+//
+//	mobula-drift -endpoint TokenSecurity response.json
+//
+// The exit status is 1 when drift was found.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/zomvs/mobula-go-sdk/drift"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+type result struct {
+	report *drift.Report
+	files  int
+}
+
+func main() {
+	endpoint := flag.String("endpoint", "", "endpoint every file belongs to (default: parent directory name)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: mobula-drift [-endpoint name] corpus...\n\nendpoints:")
+		for _, e := range v2.Endpoints {
+			fmt.Fprintf(flag.CommandLine.Output(), " %s", e.Name)
+		}
+		fmt.Fprintln(flag.CommandLine.Output())
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	results := map[string]*result{}
+	failed := false
+	for _, root := range flag.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || filepath.Ext(path) != ".json" {
+				return nil
+			}
+			name := *endpoint
+			if name == "" {
+				name = filepath.Base(filepath.Dir(path))
+			}
+			if err := check(results, name, path); err != nil {
+				fmt.Fprintf(os.Stderr, "mobula-drift: %s: %v\n", path, err)
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "mobula-drift:", err)
+			os.Exit(2)
+		}
+	}
+
+	drifted := printReport(results)
+	if failed {
+		os.Exit(2)
+	}
+	if drifted {
+		os.Exit(1)
+	}
+}
+
+func check(results map[string]*result, name, path string) error {
+	e, ok := lookup(name)
+	if !ok {
+		return fmt.Errorf("unknown endpoint %q", name)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	report, err := drift.Check(data, e.Response())
+	if err != nil {
+		return err
+	}
+
+	r, ok := results[e.Name]
+	if !ok {
+		r = &result{report: &drift.Report{Type: report.Type}}
+		results[e.Name] = r
+	}
+	r.report.Merge(report)
+	r.files++
+	return nil
+}
+
+func lookup(name string) (v2.Endpoint, bool) {
+	for _, e := range v2.Endpoints {
+		if strings.EqualFold(e.Name, name) {
+			return e, true
+		}
+	}
+	return v2.Endpoint{}, false
+}
+
+// printReport writes the drift report and returns whether any drift was found.
+func printReport(results map[string]*result) bool {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	drifted := false
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		r := results[name]
+		fmt.Fprintf(w, "%s (%s, %d responses)\n", name, r.report.Type, r.files)
+		if r.report.Empty() {
+			fmt.Fprintln(w, "  no drift")
+			continue
+		}
+		drifted = true
+		for _, i := range r.report.Issues {
+			detail := i.Got
+			if i.Kind == drift.TypeMismatch {
+				detail = fmt.Sprintf("want %s, got %s", i.Want, i.Got)
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\tx%d\n", i.Kind, i.Path, detail, i.Count)
+		}
+	}
+	w.Flush()
+	return drifted
+}
@@ -0,0 +1,79 @@
+// Command mobula-exporter serves token and pool data as Prometheus metrics.
+//
+// The config file lists the tokens and pools to fetch:
+//
+//	{
+//	  "tokens": [{"address": "0x6982508145454ce325ddbe47a25d4ec3d2311933", "blockchain": "ethereum"}],
+//	  "pools": [{"address": "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f", "blockchain": "ethereum"}],
+//	  "security": true
+//	}
+//
+// Run it and point Prometheus at /metrics:
+//
+//	mobula-exporter -config tokens.json -listen :9464 -interval 30s
+//
+// The API key comes from MOBULA_API_KEY and the base URL from
+// MOBULA_BASE_URL; without a key the demo API is used.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/metrics"
+)
+
+func main() {
+	configPath := flag.String("config", "", "config file listing tokens and pools (required)")
+	listen := flag.String("listen", ":9464", "address to serve /metrics on")
+	interval := flag.Duration("interval", 30*time.Second, "time between refreshes")
+	concurrency := flag.Int("concurrency", 4, "targets fetched at once")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: mobula-exporter -config file [-listen addr] [-interval d]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *configPath == "" || flag.NArg() > 0 || *interval <= 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	cfg, err := metrics.ParseConfig(f)
+	f.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := mobula.NewClient(&mobula.Config{
+		APIKey:  os.Getenv("MOBULA_API_KEY"),
+		BaseURL: os.Getenv("MOBULA_BASE_URL"),
+	})
+	exporter := metrics.New(client, cfg, &metrics.Options{Concurrency: *concurrency})
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go exporter.Run(ctx, *interval, func(err error) { log.Print(err) })
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", exporter)
+	srv := &http.Server{Addr: *listen, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Shutdown(context.Background())
+	}()
+	log.Printf("serving metrics for %d tokens and %d pools on %s/metrics", len(cfg.Tokens), len(cfg.Pools), *listen)
+	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
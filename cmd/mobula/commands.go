@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// table is the tabular view of a response used by the table and csv formats.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+type command struct {
+	name    string
+	summary string
+	fetch   func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error)
+	table   func(resp interface{}) table
+}
+
+var commands = []*command{
+	{
+		name:    "security",
+		summary: "token security signals",
+		fetch: func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error) {
+			return c.GetTokenSecurity(ctx, &v2.TokenSecurityRequest{Address: opts.address, Blockchain: opts.chain})
+		},
+		table: func(resp interface{}) table {
+			d := resp.(*v2.TokenSecurityResponse).Data
+			return table{
+				header: []string{"address", "chain", "buy fee %", "sell fee %", "mintable", "freezable", "launchpad", "top10 %", "contract %", "burned %"},
+				rows: [][]string{{
+					d.Address, d.ChainID, number(d.BuyFeePercentage), number(d.SellFeePercentage),
+					strconv.FormatBool(d.IsMintable), optionalBool(d.IsFreezable), strconv.FormatBool(d.IsLaunchpadToken),
+					number(d.Top10HoldingsPercentage), number(d.ContractHoldingsPercentage), number(d.BurnedHoldingsPercentage),
+				}},
+			}
+		},
+	},
+	{
+		name:    "token",
+		summary: "token details",
+		fetch: func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error) {
+			return c.GetTokenDetails(ctx, &v2.TokenDetailsRequest{Address: opts.address, Blockchain: opts.chain})
+		},
+		table: func(resp interface{}) table {
+			return tokenTable([]v2.Token{resp.(*v2.TokenDetailsResponse).Data})
+		},
+	},
+	{
+		name:    "asset",
+		summary: "asset details and its deployments",
+		fetch: func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error) {
+			req := &v2.AssetDetailsRequest{Address: opts.address, Blockchain: opts.chain, TokensLimit: opts.tokensLimit}
+			if opts.id != 0 {
+				req.ID = &opts.id
+			}
+			return c.GetAssetDetails(ctx, req)
+		},
+		table: func(resp interface{}) table {
+			return tokenTable(resp.(*v2.AssetDetailsResponse).Data.Tokens)
+		},
+	},
+	{
+		name:    "market",
+		summary: "market (pool) details",
+		fetch: func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error) {
+			return c.GetMarketDetails(ctx, &v2.MarketDetailsRequest{Address: opts.address, Blockchain: opts.chain})
+		},
+		table: func(resp interface{}) table {
+			return marketTable([]v2.Market{resp.(*v2.MarketDetailsResponse).Data})
+		},
+	},
+	{
+		name:    "markets",
+		summary: "every market of a token",
+		fetch: func(ctx context.Context, c *mobula.Client, opts *options) (interface{}, error) {
+			req := &v2.TokenMarketsRequest{Address: opts.address, Blockchain: opts.chain}
+			if opts.limit > 0 {
+				req.Limit = strconv.Itoa(opts.limit)
+			}
+			return c.GetTokenMarkets(ctx, req)
+		},
+		table: func(resp interface{}) table {
+			return marketTable(resp.(*v2.TokenMarketsResponse).Data)
+		},
+	},
+}
+
+func lookup(name string) (*command, bool) {
+	for _, c := range commands {
+		if c.name == name {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func tokenTable(tokens []v2.Token) table {
+	t := table{header: []string{"symbol", "name", "chain", "address", "price usd", "24h %", "liquidity usd", "volume 24h usd", "market cap usd", "holders"}}
+	for _, tok := range tokens {
+		t.rows = append(t.rows, []string{
+			tok.Symbol, tok.Name, tok.ChainID, tok.Address, number(tok.PriceUSD), number(tok.PriceChange24HPercentage),
+			number(tok.LiquidityUSD), number(tok.Volume24HUSD), number(tok.MarketCapUSD), strconv.Itoa(tok.HoldersCount),
+		})
+	}
+	return t
+}
+
+func marketTable(markets []v2.Market) table {
+	t := table{header: []string{"pair", "exchange", "chain", "address", "price usd", "liquidity usd", "volume 24h usd", "latest trade"}}
+	for _, m := range markets {
+		t.rows = append(t.rows, []string{
+			m.Base.Symbol + "/" + m.Quote.Symbol, m.Exchange.Name, m.Blockchain, m.Address, number(m.PriceUSD),
+			number(m.LiquidityUSD), number(m.Volume24HUSD), date(m.LatestTradeDate),
+		})
+	}
+	return t
+}
+
+func number(v float64) string {
+	return strconv.FormatFloat(v, 'g', 8, 64)
+}
+
+func optionalBool(v *bool) string {
+	if v == nil {
+		return "unknown"
+	}
+	return strconv.FormatBool(*v)
+}
+
+func date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,218 @@
+// Command mobula queries the Mobula API from the command line.
+//
+//	mobula <command> --address <address> [--chain <chain>] [flags]
+//
+// Commands mirror the v2 endpoints:
+//
+//	security   token security signals         (v2.GetTokenSecurity)
+//	token      token details                  (v2.GetTokenDetails)
+//	asset      asset details and deployments  (v2.GetAssetDetails)
+//	market     market (pool) details          (v2.GetMarketDetails)
+//	markets    every market of a token        (v2.GetTokenMarkets)
+//
+// The API key is read from MOBULA_API_KEY, or from the "apiKey" field of the
+// JSON config file (default: <user config dir>/mobula/config.json). Without a
+// key the demo API is used, as with mobula.NewClient.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+)
+
+var errReported = errors.New("error already reported")
+
+// fileConfig is the content of the config file.
+type fileConfig struct {
+	APIKey  string `json:"apiKey"`
+	BaseURL string `json:"baseURL"`
+}
+
+// options are the flags shared by every command.
+type options struct {
+	address     string
+	chain       string
+	id          int
+	limit       int
+	tokensLimit int
+	output      string
+	watch       time.Duration
+	config      string
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage(os.Stdout)
+		return
+	}
+	cmd, ok := lookup(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "mobula: unknown command %q\n\n", name)
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	opts, err := parseFlags(cmd, os.Args[2:])
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return
+		}
+		if !errors.Is(err, errReported) {
+			fmt.Fprintln(os.Stderr, "mobula:", err)
+		}
+		os.Exit(2)
+	}
+
+	client, err := newClient(opts.config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mobula:", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, client, cmd, opts, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "mobula:", err)
+		os.Exit(1)
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: mobula <command> --address <address> [--chain <chain>] [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'mobula <command> -h' for the flags of a command.")
+}
+
+func parseFlags(cmd *command, args []string) (*options, error) {
+	opts := &options{}
+	fs := flag.NewFlagSet("mobula "+cmd.name, flag.ContinueOnError)
+	fs.StringVar(&opts.address, "address", "", "token or pool address (required unless --id is set)")
+	fs.StringVar(&opts.chain, "chain", "", "blockchain name or id, e.g. ethereum or evm:1")
+	fs.StringVar(&opts.output, "output", "table", "output format: table, json, csv, ndjson or parquet")
+	fs.StringVar(&opts.output, "o", "table", "shorthand for --output")
+	fs.DurationVar(&opts.watch, "watch", 0, "refresh on this interval, e.g. 30s")
+	fs.StringVar(&opts.config, "config", "", "config file (default <user config dir>/mobula/config.json)")
+	if cmd.name == "asset" {
+		fs.IntVar(&opts.id, "id", 0, "asset id")
+		fs.IntVar(&opts.tokensLimit, "tokens-limit", 0, "max number of deployments to return (max 50)")
+	}
+	if cmd.name == "markets" {
+		fs.IntVar(&opts.limit, "limit", 0, "max number of markets to return")
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+		// The flag set has already printed the error and the usage.
+		return nil, errReported
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+	if opts.address == "" && opts.id == 0 {
+		return nil, fmt.Errorf("--address is required")
+	}
+	switch opts.output {
+	case "table", "json", "csv", "ndjson":
+	case "parquet":
+		if opts.watch > 0 {
+			return nil, fmt.Errorf("--output parquet cannot be used with --watch")
+		}
+	default:
+		return nil, fmt.Errorf("unknown output format %q", opts.output)
+	}
+	if opts.watch < 0 {
+		return nil, fmt.Errorf("--watch must be positive")
+	}
+	return opts, nil
+}
+
+// newClient builds a client from the environment and the config file. The
+// environment wins; with no key at all NewClient falls back to the demo API.
+func newClient(path string) (*mobula.Client, error) {
+	var cfg fileConfig
+	explicit := path != ""
+	if !explicit {
+		dir, err := os.UserConfigDir()
+		if err == nil {
+			path = filepath.Join(dir, "mobula", "config.json")
+		}
+	}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, &cfg); err != nil {
+				return nil, fmt.Errorf("reading %s: %w", path, err)
+			}
+		case explicit || !errors.Is(err, os.ErrNotExist):
+			return nil, err
+		}
+	}
+
+	if key := os.Getenv("MOBULA_API_KEY"); key != "" {
+		cfg.APIKey = key
+	}
+	if baseURL := os.Getenv("MOBULA_BASE_URL"); baseURL != "" {
+		cfg.BaseURL = baseURL
+	}
+
+	return mobula.NewClient(&mobula.Config{
+		APIKey:  cfg.APIKey,
+		BaseURL: cfg.BaseURL,
+	}), nil
+}
+
+// run executes cmd once, or on every tick of --watch until ctx is done.
+func run(ctx context.Context, client *mobula.Client, cmd *command, opts *options, w io.Writer) error {
+	out := newWriter(opts.output, w, opts.watch > 0)
+	if opts.watch == 0 {
+		return once(ctx, client, cmd, opts, out)
+	}
+
+	ticker := time.NewTicker(opts.watch)
+	defer ticker.Stop()
+	for {
+		if err := once(ctx, client, cmd, opts, out); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			// A failed refresh is reported and retried on the next tick.
+			fmt.Fprintln(os.Stderr, "mobula:", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func once(ctx context.Context, client *mobula.Client, cmd *command, opts *options, out writer) error {
+	resp, err := cmd.fetch(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	return out.write(time.Now(), resp, cmd.table(resp))
+}
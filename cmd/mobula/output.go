@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/export"
+)
+
+type writer interface {
+	write(at time.Time, resp interface{}, t table) error
+}
+
+func newWriter(format string, w io.Writer, watch bool) writer {
+	switch format {
+	case "json":
+		return &jsonWriter{w: w, watch: watch}
+	case "csv":
+		return &csvWriter{w: csv.NewWriter(w), watch: watch}
+	case "ndjson", "parquet":
+		return &exportWriter{w: w, format: export.Format(format)}
+	}
+	return &tableWriter{w: w, watch: watch}
+}
+
+// tableWriter prints aligned columns; when watching, every refresh starts
+// with the time it was fetched.
+type tableWriter struct {
+	w     io.Writer
+	watch bool
+}
+
+func (t *tableWriter) write(at time.Time, _ interface{}, tab table) error {
+	if t.watch {
+		fmt.Fprintf(t.w, "-- %s\n", at.Format(time.RFC3339))
+	}
+	tw := tabwriter.NewWriter(t.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(tab.header, "\t")))
+	for _, row := range tab.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if t.watch {
+		fmt.Fprintln(t.w)
+	}
+	return nil
+}
+
+// jsonWriter prints the full response; when watching, one compact document
+// per line.
+type jsonWriter struct {
+	w     io.Writer
+	watch bool
+}
+
+func (j *jsonWriter) write(_ time.Time, resp interface{}, _ table) error {
+	enc := json.NewEncoder(j.w)
+	if !j.watch {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(resp)
+}
+
+// exportWriter prints every field of the response as flat dotted columns,
+// unlike the table and csv outputs which pick a few.
+type exportWriter struct {
+	w      io.Writer
+	format export.Format
+}
+
+func (e *exportWriter) write(_ time.Time, resp interface{}, _ table) error {
+	return export.Write(e.w, e.format, resp, nil)
+}
+
+// csvWriter prints the header once; when watching, every row is prefixed
+// with the time it was fetched.
+type csvWriter struct {
+	w      *csv.Writer
+	watch  bool
+	header bool
+}
+
+func (c *csvWriter) write(at time.Time, _ interface{}, tab table) error {
+	if !c.header {
+		header := tab.header
+		if c.watch {
+			header = append([]string{"fetched at"}, header...)
+		}
+		if err := c.w.Write(header); err != nil {
+			return err
+		}
+		c.header = true
+	}
+	for _, row := range tab.rows {
+		if c.watch {
+			row = append([]string{at.UTC().Format(time.RFC3339)}, row...)
+		}
+		if err := c.w.Write(row); err != nil {
+			return err
+		}
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,192 @@
+// Package crosschain gives an asset-centric view of the tokens Mobula maps to
+// one asset: the same asset deployed or bridged on several chains. It
+// compares the deployments' prices and liquidity and flags depegs and
+// arbitrage gaps between them.
+package crosschain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// maxTokens is the most deployments the asset details endpoint returns.
+const maxTokens = 50
+
+// Options tune Fetch and Analyze. Zero thresholds disable the matching flag.
+type Options struct {
+	// MinLiquidityUSD excludes deployments with less liquidity from the
+	// prices and flags; they still count in the per-chain totals.
+	MinLiquidityUSD float64
+	// PegUSD is the price the asset is meant to hold, e.g. 1 for USD
+	// stablecoins; zero for assets without a peg.
+	PegUSD float64
+	// DepegThreshold flags deployments whose price differs from PegUSD by
+	// more than this fraction, e.g. 0.005 for half a percent.
+	DepegThreshold float64
+	// ArbitrageThreshold flags deployments whose price differs from the
+	// liquidity-weighted price by more than this fraction.
+	ArbitrageThreshold float64
+}
+
+// Chain sums the deployments of an asset on one chain.
+type Chain struct {
+	Blockchain   string
+	Deployments  int
+	LiquidityUSD float64
+	PriceUSD     float64 // liquidity-weighted over the chain's priced deployments
+}
+
+// GapKind classifies a Gap.
+type GapKind string
+
+const (
+	GapDepeg     GapKind = "depeg"
+	GapArbitrage GapKind = "arbitrage"
+)
+
+// Gap is a deployment priced away from its peg or from the other chains.
+type Gap struct {
+	Kind      GapKind
+	Token     v2.Token
+	PriceUSD  float64
+	Reference float64 // the peg or the liquidity-weighted price
+	Deviation float64 // (PriceUSD - Reference) / Reference
+}
+
+// View is the cross-chain picture of one asset.
+type View struct {
+	Asset       v2.Asset
+	Deployments []v2.Token // by liquidity, largest first
+	// Truncated is set when the asset has more deployments than the
+	// endpoint returns; the figures cover the most liquid ones.
+	Truncated    bool
+	Chains       []Chain // by liquidity, largest first
+	LiquidityUSD float64
+	PriceUSD     float64 // liquidity-weighted over the priced deployments
+	// Dispersion is the liquidity-weighted standard deviation of the
+	// deployment prices relative to PriceUSD; Spread is the highest price
+	// minus the lowest, relative to PriceUSD.
+	Dispersion float64
+	Spread     float64
+	Gaps       []Gap
+}
+
+// ByID selects an asset by its Mobula ID.
+func ByID(id int) *v2.AssetDetailsRequest {
+	return &v2.AssetDetailsRequest{ID: &id}
+}
+
+// ByContract selects an asset by any one of its deployments.
+func ByContract(address, blockchain string) *v2.AssetDetailsRequest {
+	return &v2.AssetDetailsRequest{Address: address, Blockchain: blockchain}
+}
+
+// Fetch retrieves every deployment of the asset selected by req, up to the
+// endpoint's limit of 50, and analyses them.
+func Fetch(ctx context.Context, client v2.HTTPClient, req *v2.AssetDetailsRequest, opts *Options) (*View, error) {
+	r := *req
+	r.TokensLimit = maxTokens
+	resp, err := v2.GetAssetDetails(ctx, client, &r)
+	if err != nil {
+		return nil, err
+	}
+	return Analyze(&resp.Data, opts), nil
+}
+
+// Analyze computes the cross-chain view of an asset details response.
+func Analyze(data *v2.AssetDetailsData, opts *Options) *View {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+
+	v := &View{
+		Asset:       data.Asset,
+		Deployments: append([]v2.Token(nil), data.Tokens...),
+		Truncated:   data.TokensCount > len(data.Tokens),
+		Chains:      []Chain{},
+		Gaps:        []Gap{},
+	}
+	sort.SliceStable(v.Deployments, func(i, j int) bool {
+		return v.Deployments[i].LiquidityUSD > v.Deployments[j].LiquidityUSD
+	})
+
+	chains := map[string]*Chain{}
+	var order []string
+	priced := make([]v2.Token, 0, len(v.Deployments))
+	for _, t := range v.Deployments {
+		c := chains[t.Blockchain]
+		if c == nil {
+			c = &Chain{Blockchain: t.Blockchain}
+			chains[t.Blockchain] = c
+			order = append(order, t.Blockchain)
+		}
+		c.Deployments++
+		c.LiquidityUSD += t.LiquidityUSD
+		v.LiquidityUSD += t.LiquidityUSD
+		if t.PriceUSD > 0 && t.LiquidityUSD > 0 && t.LiquidityUSD >= o.MinLiquidityUSD {
+			priced = append(priced, t)
+		}
+	}
+	for _, name := range order {
+		c := chains[name]
+		c.PriceUSD = weightedPrice(priced, name)
+		v.Chains = append(v.Chains, *c)
+	}
+	sort.SliceStable(v.Chains, func(i, j int) bool { return v.Chains[i].LiquidityUSD > v.Chains[j].LiquidityUSD })
+
+	v.PriceUSD = weightedPrice(priced, "")
+	if v.PriceUSD == 0 {
+		return v
+	}
+
+	lo, hi := math.Inf(1), math.Inf(-1)
+	var variance, weight float64
+	for _, t := range priced {
+		lo, hi = math.Min(lo, t.PriceUSD), math.Max(hi, t.PriceUSD)
+		d := t.PriceUSD/v.PriceUSD - 1
+		variance += t.LiquidityUSD * d * d
+		weight += t.LiquidityUSD
+	}
+	v.Dispersion = math.Sqrt(variance / weight)
+	v.Spread = (hi - lo) / v.PriceUSD
+
+	for _, t := range priced {
+		if o.PegUSD > 0 && o.DepegThreshold > 0 {
+			if d := t.PriceUSD/o.PegUSD - 1; math.Abs(d) > o.DepegThreshold {
+				v.Gaps = append(v.Gaps, Gap{Kind: GapDepeg, Token: t, PriceUSD: t.PriceUSD, Reference: o.PegUSD, Deviation: d})
+			}
+		}
+		if o.ArbitrageThreshold > 0 {
+			if d := t.PriceUSD/v.PriceUSD - 1; math.Abs(d) > o.ArbitrageThreshold {
+				v.Gaps = append(v.Gaps, Gap{Kind: GapArbitrage, Token: t, PriceUSD: t.PriceUSD, Reference: v.PriceUSD, Deviation: d})
+			}
+		}
+	}
+	return v
+}
+
+func (g Gap) String() string {
+	return fmt.Sprintf("%s on %s: $%g vs $%g (%+.2f%%)", g.Kind, g.Token.Blockchain, g.PriceUSD, g.Reference, 100*g.Deviation)
+}
+
+// weightedPrice is the liquidity-weighted price of tokens on blockchain, or
+// of all tokens when blockchain is empty.
+func weightedPrice(tokens []v2.Token, blockchain string) float64 {
+	var sum, weight float64
+	for _, t := range tokens {
+		if blockchain != "" && t.Blockchain != blockchain {
+			continue
+		}
+		sum += t.PriceUSD * t.LiquidityUSD
+		weight += t.LiquidityUSD
+	}
+	if weight == 0 {
+		return 0
+	}
+	return sum / weight
+}
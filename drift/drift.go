@@ -0,0 +1,360 @@
+// Package drift compares raw API responses with the Go types they decode
+// into. encoding/json silently drops fields it does not know and gives up on
+// the first type mismatch; drift reports every difference by JSON path so that
+// models can be kept in step with what Mobula actually returns.
+package drift
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Kind classifies an Issue.
+type Kind string
+
+const (
+	// UnknownField is a field present in the response but not in the Go type.
+	UnknownField Kind = "unknown field"
+	// TypeMismatch is a value whose JSON type the Go type cannot hold.
+	TypeMismatch Kind = "type mismatch"
+)
+
+// Issue is one difference between a response and its Go type. Array indices
+// are collapsed to "[]" so the same drift in every element is reported once.
+type Issue struct {
+	Path  string // JSON path, e.g. data.tokens[].security.buyTax
+	Kind  Kind
+	Want  string // Go type, empty for unknown fields
+	Got   string // JSON type found in the response
+	Count int    // occurrences of this issue
+}
+
+func (i Issue) String() string {
+	if i.Kind == UnknownField {
+		return fmt.Sprintf("%s %s (%s)", i.Kind, i.Path, i.Got)
+	}
+	return fmt.Sprintf("%s %s: want %s, got %s", i.Kind, i.Path, i.Want, i.Got)
+}
+
+// Report lists the issues found for one response, ordered by path.
+type Report struct {
+	Type   string // Go type the response was checked against
+	Issues []Issue
+}
+
+// Empty reports whether no drift was found.
+func (r *Report) Empty() bool {
+	return len(r.Issues) == 0
+}
+
+// Merge folds the issues of other into r, adding up counts.
+func (r *Report) Merge(other *Report) {
+	c := collector{issues: map[string]*Issue{}}
+	for _, rep := range []*Report{r, other} {
+		for _, i := range rep.Issues {
+			c.add(i)
+		}
+	}
+	r.Issues = c.sorted()
+}
+
+// Check compares the JSON document data with the type of v, which is usually
+// the pointer passed to json.Unmarshal.
+func Check(data []byte, v interface{}) (*Report, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, fmt.Errorf("drift: nil target")
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("drift: invalid JSON: %w", err)
+	}
+
+	c := collector{issues: map[string]*Issue{}}
+	c.walk("", doc, t)
+	return &Report{Type: t.String(), Issues: c.sorted()}, nil
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textType        = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+type collector struct {
+	issues map[string]*Issue
+}
+
+func (c *collector) add(i Issue) {
+	if i.Count == 0 {
+		i.Count = 1
+	}
+	key := string(i.Kind) + "\x00" + i.Path + "\x00" + i.Got
+	if prev, ok := c.issues[key]; ok {
+		prev.Count += i.Count
+		return
+	}
+	c.issues[key] = &i
+}
+
+func (c *collector) sorted() []Issue {
+	out := make([]Issue, 0, len(c.issues))
+	for _, i := range c.issues {
+		out = append(out, *i)
+	}
+	sort.Slice(out, func(a, b int) bool {
+		if out[a].Path != out[b].Path {
+			return out[a].Path < out[b].Path
+		}
+		return out[a].Kind < out[b].Kind
+	})
+	return out
+}
+
+func (c *collector) mismatch(path string, t reflect.Type, v interface{}) {
+	c.add(Issue{Path: path, Kind: TypeMismatch, Want: t.String(), Got: jsonType(v)})
+}
+
+func (c *collector) walk(path string, v interface{}, t reflect.Type) {
+	// null decodes into anything.
+	if v == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == timeType {
+		s, ok := v.(string)
+		if !ok {
+			c.mismatch(path, t, v)
+			return
+		}
+		if _, err := time.Parse(time.RFC3339Nano, s); err != nil {
+			c.add(Issue{Path: path, Kind: TypeMismatch, Want: t.String(), Got: fmt.Sprintf("string %q", s)})
+		}
+		return
+	}
+	// Types with their own decoding are opaque.
+	if reflect.PointerTo(t).Implements(unmarshalerType) || reflect.PointerTo(t).Implements(textType) {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		return
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			c.mismatch(path, t, v)
+			return
+		}
+		fields := jsonFields(t)
+		for key, val := range obj {
+			f, ok := lookup(fields, key)
+			if !ok {
+				c.add(Issue{Path: join(path, key), Kind: UnknownField, Got: jsonType(val)})
+				continue
+			}
+			if f.quoted {
+				c.walkQuoted(join(path, key), val, f.typ)
+				continue
+			}
+			c.walk(join(path, key), val, f.typ)
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			c.mismatch(path, t, v)
+			return
+		}
+		for _, val := range obj {
+			c.walk(path+".*", val, t.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]interface{})
+		if !ok {
+			c.mismatch(path, t, v)
+			return
+		}
+		for _, val := range arr {
+			c.walk(path+"[]", val, t.Elem())
+		}
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			c.mismatch(path, t, v)
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			c.mismatch(path, t, v)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := v.(json.Number)
+		if !ok {
+			c.mismatch(path, t, v)
+			return
+		}
+		if _, err := n.Int64(); err != nil {
+			c.add(Issue{Path: path, Kind: TypeMismatch, Want: t.String(), Got: "number " + n.String()})
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := v.(json.Number); !ok {
+			c.mismatch(path, t, v)
+		}
+	}
+}
+
+// walkQuoted checks a field tagged with the ,string option, which holds a
+// string, number or boolean encoded inside a JSON string.
+func (c *collector) walkQuoted(path string, v interface{}, t reflect.Type) {
+	if v == nil {
+		return
+	}
+	s, ok := v.(string)
+	if !ok {
+		c.mismatch(path, t, v)
+		return
+	}
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var inner interface{}
+	if err := dec.Decode(&inner); err != nil || dec.More() {
+		c.add(Issue{Path: path, Kind: TypeMismatch, Want: t.String(), Got: fmt.Sprintf("string %q", s)})
+		return
+	}
+	c.walk(path, inner, t)
+}
+
+// jsonField is a field of a struct by its JSON name.
+type jsonField struct {
+	name   string
+	typ    reflect.Type
+	quoted bool // the ,string option on a string, number or boolean
+}
+
+// jsonFields lists the fields of t by JSON name in declaration order,
+// following the rules of encoding/json for tags and embedded structs. When
+// two fields claim the same name the first one wins.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	taken := map[string]bool{}
+	add := func(f jsonField) {
+		if !taken[f.name] {
+			taken[f.name] = true
+			fields = append(fields, f)
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for _, inner := range jsonFields(ft) {
+					add(inner)
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		add(jsonField{name: name, typ: f.Type, quoted: hasOption(opts, "string") && quotable(f.Type)})
+	}
+	return fields
+}
+
+// lookup finds the field a key decodes into as encoding/json does: the field
+// named exactly key, or else the first field whose name matches it ignoring
+// case.
+func lookup(fields []jsonField, key string) (jsonField, bool) {
+	for _, f := range fields {
+		if f.name == key {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.name, key) {
+			return f, true
+		}
+	}
+	return jsonField{}, false
+}
+
+func hasOption(opts, option string) bool {
+	for opts != "" {
+		var o string
+		o, opts, _ = strings.Cut(opts, ",")
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// quotable reports whether the ,string option applies to t; encoding/json
+// ignores it on other types.
+func quotable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func jsonType(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
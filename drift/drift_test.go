@@ -0,0 +1,176 @@
+package drift_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/drift"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func issues(r *drift.Report) []string {
+	out := []string{}
+	for _, i := range r.Issues {
+		out = append(out, i.String())
+	}
+	return out
+}
+
+func TestCheckFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    []string
+	}{
+		{"market_details.json", []string{}},
+		// Fields the response leaves out decode to their zero value.
+		{"missing_field.json", []string{}},
+		{"extra_field.json", []string{
+			"unknown field data.base.launchpad (object)",
+			"unknown field data.exchange.fees (array)",
+			"unknown field data.liquidityMaxUSD (integer)",
+		}},
+		{"type_change.json", []string{
+			"type mismatch data.base.decimals: want int, got number 18.5",
+			"type mismatch data.bonded: want bool, got integer",
+			"type mismatch data.createdAt: want time.Time, got integer",
+			"type mismatch data.exchange: want v2.Exchange, got string",
+			"type mismatch data.liquidityUSD: want float64, got string",
+			"type mismatch data.quote.decimals: want int, got string",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			report, err := drift.Check(fixture(t, tt.fixture), new(v2.MarketDetailsResponse))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Type != "v2.MarketDetailsResponse" {
+				t.Errorf("type %q", report.Type)
+			}
+			if got := issues(report); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("issues %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type cased struct {
+	Name  string `json:"name"`
+	Upper int    `json:"NAME"`
+	Other string `json:"nAME"`
+}
+
+type quoted struct {
+	ID     int64    `json:"id,string"`
+	Price  *float64 `json:"price,omitempty,string"`
+	Active bool     `json:"active,string"`
+	Label  string   `json:"label,string"`
+	Tags   []string `json:"tags,string"` // ignored off scalars
+}
+
+func TestCheckFields(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		v    any
+		want []string
+	}{
+		{"exact name", `{"name": "pepe", "NAME": 1, "nAME": "x"}`, new(cased), []string{}},
+		{"first folded name", `{"Name": "pepe", "naMe": "x"}`, new(cased), []string{}},
+		{"folded mismatch", `{"Name": 1}`, new(cased), []string{"type mismatch Name: want string, got integer"}},
+		{"quoted", `{"id": "42", "price": "0.5", "active": "true", "label": "\"pepe\"", "tags": ["a"]}`, new(quoted), []string{}},
+		{"quoted null", `{"id": null, "price": "null"}`, new(quoted), []string{}},
+		{"unquoted", `{"id": 42}`, new(quoted), []string{"type mismatch id: want int64, got integer"}},
+		{"quoted wrong type", `{"id": "4.2", "active": "1", "label": "pepe"}`, new(quoted), []string{
+			`type mismatch active: want bool, got integer`,
+			`type mismatch id: want int64, got number 4.2`,
+			`type mismatch label: want string, got string "pepe"`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The fields of a struct are visited in map order; run a few
+			// times so an order-dependent match would show.
+			for range 20 {
+				report, err := drift.Check([]byte(tt.doc), tt.v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := issues(report); !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("issues %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestOnSchemaDrift(t *testing.T) {
+	body := fixture(t, "extra_field.json")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	var (
+		paths   []string
+		reports []*drift.Report
+	)
+	client := mobula.NewClient(&mobula.Config{
+		BaseURL: srv.URL,
+		OnSchemaDrift: func(path string, report *drift.Report) {
+			paths = append(paths, path)
+			reports = append(reports, report)
+		},
+	})
+	resp, err := v2.GetMarketDetails(context.Background(), client, &v2.MarketDetailsRequest{Address: "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f"})
+	if err != nil {
+		t.Fatalf("drift failed the call: %v", err)
+	}
+	if resp.Data.Base.Symbol != "PEPE" || resp.Data.Exchange.Name != "Uniswap V2" {
+		t.Errorf("known fields not decoded: %+v", resp.Data)
+	}
+	if !reflect.DeepEqual(paths, []string{v2.MarketDetails}) || len(reports) != 1 {
+		t.Fatalf("OnSchemaDrift called for %v, want once for %s", paths, v2.MarketDetails)
+	}
+	if got := issues(reports[0]); len(got) != 3 {
+		t.Errorf("issues %q, want the 3 unknown fields", got)
+	}
+
+	// Type mismatches are reported too, and the values that fit still
+	// decode.
+	body = fixture(t, "type_change.json")
+	resp, err = v2.GetMarketDetails(context.Background(), client, &v2.MarketDetailsRequest{Address: "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f"})
+	if err != nil {
+		t.Fatalf("drift failed the call: %v", err)
+	}
+	if len(reports) != 2 || len(reports[1].Issues) != 6 {
+		t.Errorf("reports %v, want a second one with 6 issues", reports)
+	}
+	if resp.Data.PriceUSD != 0.0000071 {
+		t.Errorf("priceUSD %v after a mismatch elsewhere", resp.Data.PriceUSD)
+	}
+
+	// Without drift, OnSchemaDrift stays quiet.
+	body = fixture(t, "market_details.json")
+	if _, err := v2.GetMarketDetails(context.Background(), client, &v2.MarketDetailsRequest{Address: "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 2 {
+		t.Errorf("OnSchemaDrift called for a response without drift")
+	}
+}
@@ -2,7 +2,9 @@ package mobula
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // APIError represents an error returned by the Mobula API
@@ -41,3 +43,9 @@ func parseError(statusCode int, body []byte) error {
 
 	return apiErr
 }
+
+// IsRateLimited reports whether err is an APIError for 429 Too Many Requests.
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests
+}
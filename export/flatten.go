@@ -0,0 +1,202 @@
+// Package export writes v2 responses and slices of shared types as flat
+// tables in CSV, NDJSON or Parquet.
+//
+// Nested structs become columns named by their dotted JSON path, such as
+// "data.base.symbol" for a MarketDetailsResponse. Columns follow the field
+// order of the Go types, so the same type always exports the same columns in
+// the same order:
+//
+//	resp, err := v2.GetTokenMarkets(ctx, client, req)
+//	err = export.Write(os.Stdout, export.CSV, resp, &export.Options{
+//		Columns: []string{"address", "exchange.name", "base.symbol", "quote.symbol", "liquidityUSD"},
+//	})
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/fields"
+)
+
+// ColumnType is the kind of values in a column.
+type ColumnType int
+
+const (
+	Bool   ColumnType = iota
+	Int               // int64
+	Float             // float64
+	String            // string
+	Time              // time.Time
+	JSON              // slices, maps and interfaces nested in a row, as a JSON string
+)
+
+// Column is one flattened field.
+type Column struct {
+	Name string
+	Type ColumnType
+
+	field fields.Field // from the row type
+}
+
+// Table is flattened data. A cell is nil when the value is missing, such as
+// a field under a nil pointer, or else a bool, int64, float64, string or
+// time.Time matching its column type.
+type Table struct {
+	Columns []Column
+	Rows    [][]any
+}
+
+// Options tune Flatten.
+type Options struct {
+	// Columns selects and orders the exported columns. An entry names a
+	// column, or a prefix selecting every column under it: "data.base"
+	// selects "data.base.name", "data.base.symbol" and so on. All columns are
+	// exported when empty.
+	Columns []string
+}
+
+// Flatten turns v into a table. A slice or array gives one row per element;
+// so does a response whose data field is a slice, such as
+// TokenMarketsResponse, in which case its other fields are left out.
+// Anything else gives a single row. Elements that are not structs go in a
+// column named "value".
+func Flatten(v any, opts *Options) (*Table, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	rows := rowsOf(reflect.ValueOf(v))
+	if !rows.IsValid() {
+		return nil, fmt.Errorf("export: cannot flatten %T", v)
+	}
+
+	elem := rows.Type().Elem()
+	for elem.Kind() == reflect.Pointer {
+		elem = elem.Elem()
+	}
+	var columns []Column
+	if elem.Kind() == reflect.Struct && elem != timeType {
+		for _, f := range fields.Of(elem) {
+			columns = append(columns, Column{Name: f.Name, Type: columnType(f.Type), field: f})
+		}
+	} else {
+		columns = []Column{{Name: "value", Type: columnType(elem)}}
+	}
+	columns, err := selectColumns(columns, o.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Table{Columns: columns, Rows: make([][]any, rows.Len())}
+	for i := range t.Rows {
+		row := make([]any, len(columns))
+		for j, c := range columns {
+			row[j] = cell(rows.Index(i), c)
+		}
+		t.Rows[i] = row
+	}
+	return t, nil
+}
+
+// rowsOf returns the slice of rows in v, wrapping a single value in a slice
+// of one.
+func rowsOf(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		return v
+	case reflect.Struct:
+		if f, ok := v.Type().FieldByName("Data"); ok && f.IsExported() && v.FieldByIndex(f.Index).Kind() == reflect.Slice {
+			return v.FieldByIndex(f.Index)
+		}
+	}
+	one := reflect.New(reflect.SliceOf(v.Type())).Elem()
+	return reflect.Append(one, v)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func columnType(t reflect.Type) ColumnType {
+	if t == timeType {
+		return Time
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return Bool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return Int
+	case reflect.Float32, reflect.Float64:
+		return Float
+	case reflect.String:
+		return String
+	}
+	return JSON
+}
+
+func selectColumns(columns []Column, names []string) ([]Column, error) {
+	if len(names) == 0 {
+		return columns, nil
+	}
+	var selected []Column
+	taken := map[string]bool{}
+	for _, name := range names {
+		found := false
+		for _, c := range columns {
+			if c.Name == name || strings.HasPrefix(c.Name, name+".") {
+				found = true
+				if !taken[c.Name] {
+					taken[c.Name] = true
+					selected = append(selected, c)
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("export: unknown column %q", name)
+		}
+	}
+	return selected, nil
+}
+
+// cell reads column c of row, or nil when a pointer on the way is nil.
+func cell(row reflect.Value, c Column) any {
+	v, ok := fields.Value(row, c.field)
+	if !ok {
+		return nil
+	}
+	switch c.Type {
+	case Bool:
+		return v.Bool()
+	case Int:
+		if v.CanInt() {
+			return v.Int()
+		}
+		return int64(v.Uint())
+	case Float:
+		return v.Float()
+	case String:
+		return v.String()
+	case Time:
+		return v.Interface().(time.Time)
+	}
+	if (v.Kind() == reflect.Interface || v.Kind() == reflect.Map || v.Kind() == reflect.Slice) && v.IsNil() {
+		return nil
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil
+	}
+	return string(b)
+}
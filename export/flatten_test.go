@@ -0,0 +1,223 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+func names(columns []Column) []string {
+	out := []string{}
+	for _, c := range columns {
+		out = append(out, c.Name)
+	}
+	return out
+}
+
+func pepeMarket() v2.Market {
+	return v2.Market{
+		Base:         v2.Token{Address: "0x6982508145454ce325ddbe47a25d4ec3d2311933", Symbol: "PEPE", Decimals: 18},
+		Quote:        v2.Token{Symbol: "WETH"},
+		LiquidityUSD: 12500000,
+		Address:      "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f",
+		Exchange:     v2.Exchange{Name: "Uniswap V2"},
+	}
+}
+
+func TestFlattenMarketDetails(t *testing.T) {
+	table, err := Flatten(&v2.MarketDetailsResponse{Data: pepeMarket()}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := names(table.Columns)
+	if want := []string{"data.base.address", "data.base.chainId", "data.base.symbol", "data.base.name", "data.base.decimals"}; !reflect.DeepEqual(got[:len(want)], want) {
+		t.Errorf("first columns %v, want %v", got[:len(want)], want)
+	}
+	// Nested structs expand where they are declared: quote after base, and
+	// the exchange after the fields declared before it.
+	index := map[string]int{}
+	for i, name := range got {
+		index[name] = i
+	}
+	order := []string{"data.base.symbol", "data.quote.address", "data.quote.symbol", "data.liquidityUSD", "data.address", "data.type", "data.exchange.name", "data.exchange.logo", "data.factory"}
+	for i := 1; i < len(order); i++ {
+		a, okA := index[order[i-1]]
+		b, okB := index[order[i]]
+		if !okA || !okB || a >= b {
+			t.Errorf("column %q (%d, %v) not before %q (%d, %v)", order[i-1], a, okA, order[i], b, okB)
+		}
+	}
+
+	if len(table.Rows) != 1 {
+		t.Fatalf("%d rows, want 1", len(table.Rows))
+	}
+	row := table.Rows[0]
+	for name, want := range map[string]any{
+		"data.base.symbol":   "PEPE",
+		"data.base.decimals": int64(18),
+		"data.quote.symbol":  "WETH",
+		"data.liquidityUSD":  12500000.0,
+		"data.exchange.name": "Uniswap V2",
+		"data.bonded":        false,
+	} {
+		if got := row[index[name]]; got != want {
+			t.Errorf("%s = %#v, want %#v", name, got, want)
+		}
+	}
+}
+
+func TestFlattenRows(t *testing.T) {
+	markets := []v2.Market{pepeMarket(), pepeMarket()}
+	markets[1].Base.Symbol = "WOJAK"
+	opts := &Options{Columns: []string{"base.symbol", "liquidityUSD"}}
+
+	tests := []struct {
+		name string
+		v    any
+		rows int
+	}{
+		{"data field", &v2.TokenMarketsResponse{Data: markets}, 2},
+		{"data field by value", v2.TokenMarketsResponse{Data: markets}, 2},
+		{"slice", markets, 2},
+		{"array", [1]v2.Market{markets[0]}, 1},
+		{"empty data", &v2.TokenMarketsResponse{}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := Flatten(tt.v, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := names(table.Columns); !reflect.DeepEqual(got, opts.Columns) {
+				t.Errorf("columns %v, want %v", got, opts.Columns)
+			}
+			if len(table.Rows) != tt.rows {
+				t.Fatalf("%d rows, want %d", len(table.Rows), tt.rows)
+			}
+			for i, row := range table.Rows {
+				if want := []any{markets[i].Base.Symbol, 12500000.0}; !reflect.DeepEqual(row, want) {
+					t.Errorf("row %d %v, want %v", i, row, want)
+				}
+			}
+		})
+	}
+
+	table, err := Flatten([]float64{1, 2}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := names(table.Columns); !reflect.DeepEqual(got, []string{"value"}) || table.Columns[0].Type != Float {
+		t.Errorf("columns of a float slice %+v, want one float column named value", table.Columns)
+	}
+
+	if _, err := Flatten((*v2.TokenMarketsResponse)(nil), nil); err == nil {
+		t.Error("flattening a nil response did not fail")
+	}
+}
+
+func TestSelectColumns(t *testing.T) {
+	tests := []struct {
+		columns []string
+		want    []string
+		err     string
+	}{
+		{[]string{"data.exchange"}, []string{"data.exchange.name", "data.exchange.logo"}, ""},
+		{[]string{"data.liquidityUSD", "data.exchange.name"}, []string{"data.liquidityUSD", "data.exchange.name"}, ""},
+		{[]string{"data.exchange.logo", "data.exchange"}, []string{"data.exchange.logo", "data.exchange.name"}, ""},
+		{[]string{"data.base.symbol", "data.base.symbol"}, []string{"data.base.symbol"}, ""},
+		{[]string{"data.bas"}, nil, `unknown column "data.bas"`},
+		{[]string{"data.base.symbol", "liquidityUSD"}, nil, `unknown column "liquidityUSD"`},
+	}
+	for _, tt := range tests {
+		table, err := Flatten(&v2.MarketDetailsResponse{}, &Options{Columns: tt.columns})
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("%v: error %v, want %q", tt.columns, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: %v", tt.columns, err)
+			continue
+		}
+		if got := names(table.Columns); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v: columns %v, want %v", tt.columns, got, tt.want)
+		}
+	}
+
+	table, err := Flatten(&v2.MarketDetailsResponse{}, &Options{Columns: []string{"data.base"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table.Columns) < 10 {
+		t.Fatalf("data.base selected %v", names(table.Columns))
+	}
+	for _, c := range table.Columns {
+		if !strings.HasPrefix(c.Name, "data.base.") {
+			t.Errorf("data.base selected %q", c.Name)
+		}
+	}
+}
+
+// Test types with the shapes the v2 models do not have.
+type holder struct {
+	Address string  `json:"address"`
+	Balance float64 `json:"balance"`
+}
+
+type position struct {
+	Wallet string         `json:"wallet"`
+	Top    *holder        `json:"top"`
+	Tags   []string       `json:"tags"`
+	Extra  map[string]any `json:"extra"`
+	Since  time.Time      `json:"since"`
+}
+
+func positions() []position {
+	return []position{
+		{
+			Wallet: "0xabc", Top: &holder{Address: "0xdef", Balance: 2.5},
+			Tags: []string{"whale", "a,b"}, Extra: map[string]any{"n": 1},
+			Since: time.Date(2026, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600)),
+		},
+		{Wallet: "0x123"},
+	}
+}
+
+func TestWriteText(t *testing.T) {
+	table, err := Flatten(positions(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"wallet", "top.address", "top.balance", "tags", "extra", "since"}; !reflect.DeepEqual(names(table.Columns), want) {
+		t.Fatalf("columns %v, want %v", names(table.Columns), want)
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{CSV, `wallet,top.address,top.balance,tags,extra,since
+0xabc,0xdef,2.5,"[""whale"",""a,b""]","{""n"":1}",2026-01-01T11:00:00Z
+0x123,,,,,0001-01-01T00:00:00Z
+`},
+		{NDJSON, `{"wallet":"0xabc","top.address":"0xdef","top.balance":2.5,"tags":["whale","a,b"],"extra":{"n":1},"since":"2026-01-01T12:00:00+01:00"}
+{"wallet":"0x123","top.address":null,"top.balance":null,"tags":null,"extra":null,"since":"0001-01-01T00:00:00Z"}
+`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Write(&buf, tt.format, positions(), nil); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
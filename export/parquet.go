@@ -0,0 +1,278 @@
+package export
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"time"
+)
+
+// WriteParquet writes the table as a Parquet file with one row group and one
+// uncompressed, PLAIN-encoded page per column. Every column is optional and
+// named by its dotted path at the top level of the schema. Strings and JSON
+// columns are UTF8 byte arrays, times are INT64 TIMESTAMP_MILLIS in UTC.
+//
+// The whole file is built in memory before it is written, so very large
+// exports should be split, for example one file per backfill chunk.
+func (t *Table) WriteParquet(w io.Writer) error {
+	if len(t.Columns) == 0 {
+		return errors.New("export: parquet needs at least one column")
+	}
+	file := []byte(parquetMagic)
+	chunks := make([]columnChunk, len(t.Columns))
+	for i, c := range t.Columns {
+		page := t.page(i)
+		var h thriftWriter
+		h.pageHeader(len(t.Rows), len(page))
+		chunks[i] = columnChunk{
+			offset: int64(len(file)),
+			size:   int64(len(h.buf) + len(page)),
+			typ:    physicalType(c.Type),
+		}
+		file = append(file, h.buf...)
+		file = append(file, page...)
+	}
+
+	var m thriftWriter
+	m.fileMetaData(t, chunks)
+	file = append(file, m.buf...)
+	file = binary.LittleEndian.AppendUint32(file, uint32(len(m.buf)))
+	file = append(file, parquetMagic...)
+	_, err := w.Write(file)
+	return err
+}
+
+const parquetMagic = "PAR1"
+
+// Parquet physical types, repetitions, converted types and encodings from
+// the format's parquet.thrift.
+const (
+	typeBoolean   = 0
+	typeInt64     = 2
+	typeDouble    = 5
+	typeByteArray = 6
+
+	repetitionOptional = 1
+
+	convertedUTF8            = 0
+	convertedTimestampMillis = 9
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	codecUncompressed = 0
+	pageData          = 0
+)
+
+func physicalType(t ColumnType) int32 {
+	switch t {
+	case Bool:
+		return typeBoolean
+	case Int, Time:
+		return typeInt64
+	case Float:
+		return typeDouble
+	}
+	return typeByteArray
+}
+
+type columnChunk struct {
+	offset, size int64
+	typ          int32
+}
+
+// page encodes column i as the body of a v1 data page: the definition levels
+// then the PLAIN values of the rows that are not null.
+func (t *Table) page(i int) []byte {
+	var levels []byte
+	for start := 0; start < len(t.Rows); {
+		defined := t.Rows[start][i] != nil
+		n := 1
+		for start+n < len(t.Rows) && (t.Rows[start+n][i] != nil) == defined {
+			n++
+		}
+		// RLE run of the 1-bit level: header n<<1, then the value in a byte.
+		levels = binary.AppendUvarint(levels, uint64(n)<<1)
+		if defined {
+			levels = append(levels, 1)
+		} else {
+			levels = append(levels, 0)
+		}
+		start += n
+	}
+	buf := binary.LittleEndian.AppendUint32(nil, uint32(len(levels)))
+	buf = append(buf, levels...)
+
+	var bits, nbits byte
+	for _, row := range t.Rows {
+		switch v := row[i].(type) {
+		case bool:
+			if v {
+				bits |= 1 << nbits
+			}
+			if nbits++; nbits == 8 {
+				buf = append(buf, bits)
+				bits, nbits = 0, 0
+			}
+		case int64:
+			buf = binary.LittleEndian.AppendUint64(buf, uint64(v))
+		case time.Time:
+			buf = binary.LittleEndian.AppendUint64(buf, uint64(v.UnixMilli()))
+		case float64:
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+		case string:
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(v)))
+			buf = append(buf, v...)
+		}
+	}
+	if nbits > 0 {
+		buf = append(buf, bits)
+	}
+	return buf
+}
+
+// thriftWriter encodes the Parquet metadata structs with the Thrift compact
+// protocol.
+type thriftWriter struct {
+	buf    []byte
+	last   int16 // last field id of the current struct
+	parent []int16
+}
+
+// Compact protocol type ids.
+const (
+	tI32    = 5
+	tI64    = 6
+	tBinary = 8
+	tList   = 9
+	tStruct = 12
+)
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	if delta := id - w.last; delta > 0 && delta <= 15 {
+		w.buf = append(w.buf, byte(delta)<<4|typ)
+	} else {
+		w.buf = append(w.buf, typ)
+		w.buf = binary.AppendVarint(w.buf, int64(id))
+	}
+	w.last = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, tI32)
+	w.buf = binary.AppendVarint(w.buf, int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, tI64)
+	w.buf = binary.AppendVarint(w.buf, v)
+}
+
+func (w *thriftWriter) binary(id int16, s string) {
+	w.field(id, tBinary)
+	w.str(s)
+}
+
+func (w *thriftWriter) str(s string) {
+	w.buf = binary.AppendUvarint(w.buf, uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+func (w *thriftWriter) list(id int16, elem byte, n int) {
+	w.field(id, tList)
+	if n < 15 {
+		w.buf = append(w.buf, byte(n)<<4|elem)
+	} else {
+		w.buf = append(w.buf, 0xf0|elem)
+		w.buf = binary.AppendUvarint(w.buf, uint64(n))
+	}
+}
+
+// begin starts a struct, as a field when id is non-zero or else as a list
+// element; end closes it.
+func (w *thriftWriter) begin(id int16) {
+	if id != 0 {
+		w.field(id, tStruct)
+	}
+	w.parent = append(w.parent, w.last)
+	w.last = 0
+}
+
+func (w *thriftWriter) end() {
+	w.buf = append(w.buf, 0)
+	w.last = w.parent[len(w.parent)-1]
+	w.parent = w.parent[:len(w.parent)-1]
+}
+
+func (w *thriftWriter) pageHeader(values, size int) {
+	w.begin(0)
+	w.i32(1, pageData)
+	w.i32(2, int32(size)) // uncompressed
+	w.i32(3, int32(size)) // compressed
+	w.begin(5)            // DataPageHeader
+	w.i32(1, int32(values))
+	w.i32(2, encodingPlain)
+	w.i32(3, encodingRLE) // definition levels
+	w.i32(4, encodingRLE) // repetition levels
+	w.end()
+	w.end()
+}
+
+func (w *thriftWriter) fileMetaData(t *Table, chunks []columnChunk) {
+	w.begin(0)
+	w.i32(1, 1) // version
+
+	w.list(2, tStruct, len(t.Columns)+1)
+	w.begin(0)
+	w.binary(4, "schema")
+	w.i32(5, int32(len(t.Columns)))
+	w.end()
+	for i, c := range t.Columns {
+		w.begin(0)
+		w.i32(1, chunks[i].typ)
+		w.i32(3, repetitionOptional)
+		w.binary(4, c.Name)
+		switch c.Type {
+		case String, JSON:
+			w.i32(6, convertedUTF8)
+		case Time:
+			w.i32(6, convertedTimestampMillis)
+		}
+		w.end()
+	}
+
+	w.i64(3, int64(len(t.Rows)))
+
+	var total int64
+	for _, c := range chunks {
+		total += c.size
+	}
+	w.list(4, tStruct, 1)
+	w.begin(0) // RowGroup
+	w.list(1, tStruct, len(chunks))
+	for i, c := range chunks {
+		w.begin(0) // ColumnChunk
+		w.i64(2, c.offset)
+		w.begin(3) // ColumnMetaData
+		w.i32(1, c.typ)
+		w.list(2, tI32, 2)
+		w.buf = binary.AppendVarint(w.buf, encodingPlain)
+		w.buf = binary.AppendVarint(w.buf, encodingRLE)
+		w.list(3, tBinary, 1)
+		w.str(t.Columns[i].Name)
+		w.i32(4, codecUncompressed)
+		w.i64(5, int64(len(t.Rows)))
+		w.i64(6, c.size)
+		w.i64(7, c.size)
+		w.i64(9, c.offset)
+		w.end()
+		w.end()
+	}
+	w.i64(2, total)
+	w.i64(3, int64(len(t.Rows)))
+	w.end()
+
+	w.binary(6, "mobula-go-sdk export")
+	w.end()
+}
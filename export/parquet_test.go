@@ -0,0 +1,386 @@
+package export
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// thriftReader decodes Thrift compact protocol structs into maps keyed by
+// field id, with integers as int64, binaries as strings, lists as []any and
+// structs as map[int16]any. It is the reading side of thriftWriter, enough
+// to check the files WriteParquet produces.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.buf) {
+		panic("thrift: unexpected end of input")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		panic("thrift: bad varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) varint() int64 {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n <= 0 {
+		panic("thrift: bad varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case 1:
+		return true
+	case 2:
+		return false
+	case tI32, tI64:
+		return r.varint()
+	case tBinary:
+		n := int(r.uvarint())
+		if r.pos+n > len(r.buf) {
+			panic("thrift: binary past the end")
+		}
+		s := string(r.buf[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case tList:
+		h := r.byte()
+		n, elem := int(h>>4), h&0x0f
+		if n == 15 {
+			n = int(r.uvarint())
+		}
+		list := make([]any, n)
+		for i := range list {
+			list[i] = r.value(elem)
+		}
+		return list
+	case tStruct:
+		return r.structure()
+	}
+	panic(fmt.Sprintf("thrift: unsupported type %d", typ))
+}
+
+func (r *thriftReader) structure() map[int16]any {
+	s := map[int16]any{}
+	var last int16
+	for {
+		h := r.byte()
+		if h == 0 {
+			return s
+		}
+		typ := h & 0x0f
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			id = int16(r.varint())
+		}
+		if _, dup := s[id]; dup {
+			panic(fmt.Sprintf("thrift: field %d twice", id))
+		}
+		s[id] = r.value(typ)
+		last = id
+	}
+}
+
+// decode reads one struct from buf and returns it with the bytes consumed.
+func decode(t *testing.T, buf []byte) (s map[int16]any, n int) {
+	t.Helper()
+	defer func() {
+		if err := recover(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	r := &thriftReader{buf: buf}
+	return r.structure(), r.pos
+}
+
+// field returns field id of a decoded struct, failing the test when it is
+// missing or of another type.
+func field[T any](t *testing.T, what string, s map[int16]any, id int16) T {
+	t.Helper()
+	v, ok := s[id].(T)
+	if !ok {
+		t.Fatalf("%s: field %d = %#v, want a %T", what, id, s[id], *new(T))
+	}
+	return v
+}
+
+// expect checks the fields of a decoded struct; nil wants the field absent.
+func expect(t *testing.T, what string, s map[int16]any, want map[int16]any) {
+	t.Helper()
+	for id, w := range want {
+		got, ok := s[id]
+		switch {
+		case w == nil && ok:
+			t.Errorf("%s: field %d = %v, want it absent", what, id, got)
+		case w != nil && fmt.Sprint(got) != fmt.Sprint(w):
+			t.Errorf("%s: field %d = %v, want %v", what, id, got, w)
+		}
+	}
+}
+
+// levels decodes n RLE/bit-packed hybrid definition levels of bit width 1.
+func levels(t *testing.T, buf []byte, n int) []bool {
+	t.Helper()
+	var out []bool
+	for pos := 0; len(out) < n; {
+		h, k := binary.Uvarint(buf[pos:])
+		if k <= 0 || pos+k >= len(buf) {
+			t.Fatalf("bad level run header at %d", pos)
+		}
+		pos += k
+		if h&1 == 0 { // RLE run: the count, then the value in one byte
+			v := buf[pos] == 1
+			for range h >> 1 {
+				out = append(out, v)
+			}
+			pos++
+			continue
+		}
+		groups := int(h >> 1) // bit-packed: groups of 8 values, one byte each
+		for j := range groups * 8 {
+			out = append(out, buf[pos+j/8]>>(j%8)&1 == 1)
+		}
+		pos += groups
+		out = out[:min(len(out), n)]
+	}
+	if len(out) != n {
+		t.Fatalf("levels cover %d rows, want %d", len(out), n)
+	}
+	return out
+}
+
+// readColumn decodes the data page of a column back into cells.
+func readColumn(t *testing.T, page []byte, typ ColumnType, rows int) []any {
+	t.Helper()
+	n := int(binary.LittleEndian.Uint32(page))
+	defined := levels(t, page[4:4+n], rows)
+	values := page[4+n:]
+
+	cells := make([]any, rows)
+	bit := 0
+	for i, ok := range defined {
+		if !ok {
+			continue
+		}
+		switch typ {
+		case Bool:
+			cells[i] = values[bit/8]>>(bit%8)&1 == 1
+			bit++
+		case Int:
+			cells[i] = int64(binary.LittleEndian.Uint64(values))
+			values = values[8:]
+		case Float:
+			cells[i] = math.Float64frombits(binary.LittleEndian.Uint64(values))
+			values = values[8:]
+		case Time:
+			cells[i] = time.UnixMilli(int64(binary.LittleEndian.Uint64(values))).UTC()
+			values = values[8:]
+		case String, JSON:
+			l := int(binary.LittleEndian.Uint32(values))
+			cells[i] = string(values[4 : 4+l])
+			values = values[4+l:]
+		}
+	}
+	if typ == Bool {
+		values = values[(bit+7)/8:]
+	}
+	if len(values) != 0 {
+		t.Errorf("%d bytes left after the values", len(values))
+	}
+	return cells
+}
+
+func testTable() *Table {
+	t := &Table{Columns: []Column{
+		{Name: "flag", Type: Bool},
+		{Name: "count", Type: Int},
+		{Name: "data.priceUSD", Type: Float},
+		{Name: "data.symbol", Type: String},
+		{Name: "data.createdAt", Type: Time},
+		{Name: "data.tags", Type: JSON},
+	}}
+	at := time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC)
+	for i := range 20 {
+		row := []any{
+			i%3 == 0,
+			int64(i*1000 - 5000),
+			1.5 * float64(i),
+			fmt.Sprintf("TOK%d", i),
+			at.Add(time.Duration(i) * time.Hour),
+			fmt.Sprintf(`["t%d"]`, i),
+		}
+		if i >= 5 && i < 9 {
+			row[1], row[2], row[4] = nil, nil, nil
+		}
+		if i%4 == 1 {
+			row[0], row[3] = nil, nil
+		}
+		t.Rows = append(t.Rows, row)
+	}
+	t.Rows[19][5] = nil
+	return t
+}
+
+func TestWriteParquetRoundTrip(t *testing.T) {
+	wide := &Table{}
+	for i := range 16 {
+		wide.Columns = append(wide.Columns, Column{Name: fmt.Sprintf("c%d", i), Type: Float})
+	}
+	wide.Rows = [][]any{make([]any, 16)}
+	for i := range wide.Rows[0] {
+		wide.Rows[0][i] = float64(i)
+	}
+
+	for name, table := range map[string]*Table{
+		"types": testTable(),
+		"wide":  wide,
+		"empty": {Columns: []Column{{Name: "x", Type: Int}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := table.WriteParquet(&out); err != nil {
+				t.Fatal(err)
+			}
+			roundTrip(t, table, out.Bytes())
+		})
+	}
+}
+
+// TestWriteParquetGolden pins the bytes of the test table as a Parquet file.
+// Reading it back above only checks the writer against its own reader, so a
+// rewritten golden file should also be opened with an independent reader
+// before it is committed:
+//
+//	python3 -c 'import pyarrow.parquet as pq; print(pq.read_table("export/testdata/table.parquet").to_pylist())'
+//
+// which must print the rows of testTable, with None for the missing cells.
+func TestWriteParquetGolden(t *testing.T) {
+	var out bytes.Buffer
+	if err := testTable().WriteParquet(&out); err != nil {
+		t.Fatal(err)
+	}
+	golden := filepath.Join("testdata", "table.parquet")
+	if *update {
+		if err := os.WriteFile(golden, out.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out.Bytes(), want) {
+		t.Errorf("parquet output differs from %s; run go test ./export -update and check the file with an independent reader", golden)
+	}
+	roundTrip(t, testTable(), want)
+}
+
+func roundTrip(t *testing.T, table *Table, file []byte) {
+	if len(file) < 12 || string(file[:4]) != parquetMagic || string(file[len(file)-4:]) != parquetMagic {
+		t.Fatalf("file is not framed by %s", parquetMagic)
+	}
+	footer := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	start := len(file) - 8 - footer
+	if start < 4 {
+		t.Fatalf("footer length %d does not fit a %d byte file", footer, len(file))
+	}
+	meta, n := decode(t, file[start:len(file)-8])
+	if n != footer {
+		t.Fatalf("metadata takes %d bytes, footer says %d", n, footer)
+	}
+	rows := len(table.Rows)
+	expect(t, "file metadata", meta, map[int16]any{1: 1, 3: rows, 6: "mobula-go-sdk export"})
+
+	schema := field[[]any](t, "file metadata", meta, 2)
+	if len(schema) != len(table.Columns)+1 {
+		t.Fatalf("schema has %d elements, want %d", len(schema), len(table.Columns)+1)
+	}
+	expect(t, "schema root", schema[0].(map[int16]any), map[int16]any{1: nil, 4: "schema", 5: len(table.Columns)})
+	for i, c := range table.Columns {
+		converted := map[ColumnType]any{String: convertedUTF8, JSON: convertedUTF8, Time: convertedTimestampMillis}[c.Type]
+		expect(t, c.Name+" schema", schema[i+1].(map[int16]any), map[int16]any{
+			1: physicalType(c.Type), 3: repetitionOptional, 4: c.Name, 5: nil, 6: converted,
+		})
+	}
+
+	groups := field[[]any](t, "file metadata", meta, 4)
+	if len(groups) != 1 {
+		t.Fatalf("%d row groups, want 1", len(groups))
+	}
+	group := groups[0].(map[int16]any)
+	chunks := field[[]any](t, "row group", group, 1)
+	if len(chunks) != len(table.Columns) {
+		t.Fatalf("%d column chunks, want %d", len(chunks), len(table.Columns))
+	}
+
+	next := int64(len(parquetMagic))
+	var total int64
+	for i, c := range table.Columns {
+		chunk := chunks[i].(map[int16]any)
+		md := field[map[int16]any](t, c.Name+" chunk", chunk, 3)
+		offset := field[int64](t, c.Name+" metadata", md, 9)
+		size := field[int64](t, c.Name+" metadata", md, 7)
+		if offset != next {
+			t.Errorf("%s starts at %d, want %d right after the previous chunk", c.Name, offset, next)
+		}
+		next = offset + size
+		total += size
+		expect(t, c.Name+" chunk", chunk, map[int16]any{2: offset})
+		expect(t, c.Name+" metadata", md, map[int16]any{
+			1: physicalType(c.Type), 2: []any{encodingPlain, encodingRLE}, 3: []any{c.Name},
+			4: codecUncompressed, 5: rows, 6: size,
+		})
+
+		header, hn := decode(t, file[offset:next])
+		page := file[offset+int64(hn) : next]
+		expect(t, c.Name+" page header", header, map[int16]any{1: pageData, 2: len(page), 3: len(page), 4: nil})
+		expect(t, c.Name+" data page header", field[map[int16]any](t, c.Name+" page header", header, 5), map[int16]any{
+			1: rows, 2: encodingPlain, 3: encodingRLE, 4: encodingRLE,
+		})
+
+		got := readColumn(t, page, c.Type, rows)
+		for r, row := range table.Rows {
+			want := row[i]
+			if tm, ok := want.(time.Time); ok {
+				want = tm.UTC()
+			}
+			if fmt.Sprint(got[r]) != fmt.Sprint(want) {
+				t.Errorf("%s row %d = %v, want %v", c.Name, r, got[r], want)
+			}
+		}
+	}
+	if next != int64(start) {
+		t.Errorf("column chunks end at %d, metadata starts at %d", next, start)
+	}
+	expect(t, "row group", group, map[int16]any{2: total, 3: rows})
+}
+
+func TestWriteParquetNoColumns(t *testing.T) {
+	if err := (&Table{}).WriteParquet(&bytes.Buffer{}); err == nil {
+		t.Error("a table without columns was written")
+	}
+}
@@ -0,0 +1,131 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format is an output file format.
+type Format string
+
+const (
+	CSV     Format = "csv"
+	NDJSON  Format = "ndjson"
+	Parquet Format = "parquet"
+)
+
+// ParseFormat reads a format name, as given to a --format flag.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(s)); f {
+	case CSV, NDJSON, Parquet:
+		return f, nil
+	}
+	return "", fmt.Errorf("export: unknown format %q, want csv, ndjson or parquet", s)
+}
+
+// Write flattens v and writes it to w in format f.
+func Write(w io.Writer, f Format, v any, opts *Options) error {
+	t, err := Flatten(v, opts)
+	if err != nil {
+		return err
+	}
+	switch f {
+	case CSV:
+		return t.WriteCSV(w)
+	case NDJSON:
+		return t.WriteNDJSON(w)
+	case Parquet:
+		return t.WriteParquet(w)
+	}
+	return fmt.Errorf("export: unknown format %q", f)
+}
+
+// WriteCSV writes a header of column names and one record per row. Missing
+// values are empty, times are RFC 3339 in UTC.
+func (t *Table) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	record := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		record[i] = c.Name
+	}
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for _, row := range t.Rows {
+		for i, v := range row {
+			record[i] = text(v)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func text(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case string:
+		return v
+	}
+	return fmt.Sprint(v)
+}
+
+// WriteNDJSON writes one flat JSON object per row, with the dotted column
+// names as keys in column order. Missing values and non-finite numbers are
+// null; JSON columns hold the nested value itself rather than a string.
+func (t *Table) WriteNDJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	keys := make([][]byte, len(t.Columns))
+	for i, c := range t.Columns {
+		keys[i], _ = json.Marshal(c.Name)
+	}
+	for _, row := range t.Rows {
+		bw.WriteByte('{')
+		for i, v := range row {
+			if i > 0 {
+				bw.WriteByte(',')
+			}
+			bw.Write(keys[i])
+			bw.WriteByte(':')
+			if err := writeJSONValue(bw, t.Columns[i].Type, v); err != nil {
+				return err
+			}
+		}
+		bw.WriteString("}\n")
+	}
+	return bw.Flush()
+}
+
+func writeJSONValue(w *bufio.Writer, typ ColumnType, v any) error {
+	if f, ok := v.(float64); ok && (math.IsNaN(f) || math.IsInf(f, 0)) {
+		v = nil
+	}
+	if s, ok := v.(string); ok && typ == JSON {
+		_, err := w.WriteString(s)
+		return err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
@@ -0,0 +1,59 @@
+package history
+
+import (
+	"sync"
+	"time"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Token identifies a token on a chain.
+type Token struct {
+	Address    string
+	Blockchain string
+}
+
+// DayKey identifies the candles of one token over one UTC day.
+type DayKey struct {
+	Token
+	Period v2.OHLCVPeriod
+	Day    time.Time // midnight UTC
+}
+
+// Cache stores the candles of past days. Implementations must be safe for
+// concurrent use; a persistent cache lets batches resume across runs.
+type Cache interface {
+	Get(key DayKey) ([]v2.OHLCVCandle, bool)
+	Put(key DayKey, candles []v2.OHLCVCandle)
+}
+
+// MemoryCache is an unbounded in-memory Cache.
+type MemoryCache struct {
+	mu   sync.RWMutex
+	days map[DayKey][]v2.OHLCVCandle
+}
+
+// NewMemoryCache creates an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{days: map[DayKey][]v2.OHLCVCandle{}}
+}
+
+func (c *MemoryCache) Get(key DayKey) ([]v2.OHLCVCandle, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	candles, ok := c.days[key]
+	return candles, ok
+}
+
+func (c *MemoryCache) Put(key DayKey, candles []v2.OHLCVCandle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.days[key] = candles
+}
+
+// Len returns the number of cached days.
+func (c *MemoryCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.days)
+}
@@ -0,0 +1,191 @@
+// Package history looks up the price of tokens at arbitrary points in time.
+// Prices come from the token OHLCV history endpoint, fetched one UTC day at
+// a time and cached per day, so batches of many (token, time) pairs cost one
+// request per distinct token and day.
+//
+//	prices := history.New(client, nil)
+//	res, err := prices.Batch(ctx, []history.Point{
+//		{Address: "0x...", Blockchain: "ethereum", Time: t1},
+//		{Address: "0x...", Blockchain: "ethereum", Time: t2},
+//	})
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Point is a token at a point in time.
+type Point struct {
+	Address    string
+	Blockchain string
+	Time       time.Time
+}
+
+// Price is the price found for a Point. A candle's price is its close, dated
+// at the end of the candle; At is that date and Delta how far it lies from
+// the requested time. Found is false when no candle ended within the
+// tolerance, in which case PriceUSD is zero.
+type Price struct {
+	Point
+	PriceUSD  float64
+	At        time.Time
+	Delta     time.Duration // At minus Point.Time
+	Tolerance time.Duration
+	Found     bool
+}
+
+// Options tune a Lookup.
+type Options struct {
+	Period    v2.OHLCVPeriod // candle resolution, default 1h
+	Tolerance time.Duration  // max distance to the data point used, default one period
+	Cache     Cache          // default an unbounded MemoryCache
+}
+
+var periods = map[v2.OHLCVPeriod]time.Duration{
+	v2.OHLCVPeriod1Min:  time.Minute,
+	v2.OHLCVPeriod5Min:  5 * time.Minute,
+	v2.OHLCVPeriod15Min: 15 * time.Minute,
+	v2.OHLCVPeriod1H:    time.Hour,
+	v2.OHLCVPeriod4H:    4 * time.Hour,
+	v2.OHLCVPeriod1D:    24 * time.Hour,
+}
+
+const day = 24 * time.Hour
+
+// Lookup answers point-in-time price queries.
+type Lookup struct {
+	client    v2.HTTPClient
+	period    v2.OHLCVPeriod
+	length    time.Duration
+	tolerance time.Duration
+	cache     Cache
+}
+
+// New creates a Lookup reading through client.
+func New(client v2.HTTPClient, opts *Options) *Lookup {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Period == "" {
+		o.Period = v2.OHLCVPeriod1H
+	}
+	l := &Lookup{client: client, period: o.Period, length: periods[o.Period], tolerance: o.Tolerance, cache: o.Cache}
+	if l.length == 0 {
+		l.length = time.Hour
+	}
+	if l.tolerance <= 0 {
+		l.tolerance = l.length
+	}
+	if l.cache == nil {
+		l.cache = NewMemoryCache()
+	}
+	return l
+}
+
+// PriceAt returns the price of one token at t.
+func (l *Lookup) PriceAt(ctx context.Context, address, blockchain string, t time.Time) (*Price, error) {
+	res, err := l.Batch(ctx, []Point{{Address: address, Blockchain: blockchain, Time: t}})
+	if err != nil {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// Batch returns the price of every point, in order. Duplicate points are
+// looked up once, and candles are fetched once per token and day, including
+// the neighbouring day when a point lies within the tolerance of midnight.
+func (l *Lookup) Batch(ctx context.Context, points []Point) ([]Price, error) {
+	type pointKey struct {
+		token Token
+		time  int64
+	}
+	found := map[pointKey]Price{}
+	out := make([]Price, len(points))
+	for i, p := range points {
+		key := pointKey{tokenOf(p), p.Time.UnixNano()}
+		if price, ok := found[key]; ok {
+			out[i] = price
+			continue
+		}
+		price, err := l.lookup(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		found[key] = price
+		out[i] = price
+	}
+	return out, nil
+}
+
+func (l *Lookup) lookup(ctx context.Context, p Point) (Price, error) {
+	price := Price{Point: p, Tolerance: l.tolerance}
+	token := tokenOf(p)
+	t := p.Time.UTC()
+	first := t.Add(-l.tolerance).Truncate(day)
+	last := t.Add(l.tolerance).Truncate(day)
+	for d := first; !d.After(last); d = d.Add(day) {
+		candles, err := l.day(ctx, token, d)
+		if err != nil {
+			return price, err
+		}
+		for _, c := range candles {
+			at := time.UnixMilli(c.Time).UTC().Add(l.length)
+			delta := at.Sub(t)
+			if abs(delta) > l.tolerance || (price.Found && abs(delta) >= abs(price.Delta)) {
+				continue
+			}
+			price.PriceUSD, price.At, price.Delta, price.Found = c.Close, at, delta, true
+		}
+	}
+	return price, nil
+}
+
+// day returns the candles opening on the UTC day starting at start. Days that
+// are not over yet are fetched but not cached.
+func (l *Lookup) day(ctx context.Context, token Token, start time.Time) ([]v2.OHLCVCandle, error) {
+	key := DayKey{Token: token, Period: l.period, Day: start}
+	if candles, ok := l.cache.Get(key); ok {
+		return candles, nil
+	}
+	now := time.Now()
+	if start.After(now) {
+		return nil, nil
+	}
+	resp, err := v2.GetTokenOHLCVHistory(ctx, l.client, &v2.TokenOHLCVHistoryRequest{
+		Address:    token.Address,
+		Blockchain: token.Blockchain,
+		From:       start.UnixMilli(),
+		To:         start.Add(day).UnixMilli() - 1,
+		Period:     l.period,
+		Amount:     int(day / l.length),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("history: %s on %s: %w", token.Address, start.Format(time.DateOnly), err)
+	}
+	candles := resp.Data
+	sort.Slice(candles, func(i, j int) bool { return candles[i].Time < candles[j].Time })
+	if !start.Add(day).After(now) {
+		l.cache.Put(key, candles)
+	}
+	return candles, nil
+}
+
+// tokenOf normalizes the token of p.
+func tokenOf(p Point) Token {
+	return Token{Address: address.Normalize(p.Address), Blockchain: strings.ToLower(p.Blockchain)}
+}
+
+func abs(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
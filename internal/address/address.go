@@ -0,0 +1,26 @@
+// Package address normalizes token, pool and wallet addresses, so every
+// package keying state by address agrees on when two spellings are the same.
+package address
+
+import "strings"
+
+// Normalize folds EVM addresses, which are case-insensitive, to lower case.
+// Other addresses, such as Solana's base58 ones, are case-sensitive and kept
+// as they are.
+func Normalize(address string) string {
+	if strings.HasPrefix(address, "0x") {
+		return strings.ToLower(address)
+	}
+	return address
+}
+
+// Equal reports whether a and b are the same address.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// Key identifies an address on a blockchain: the lowercase blockchain and
+// the normalized address, joined by a colon.
+func Key(blockchain, address string) string {
+	return strings.ToLower(blockchain) + ":" + Normalize(address)
+}
@@ -0,0 +1,154 @@
+// Package fields lists the fields of the v2 models by their JSON names, so
+// every package reading models generically (alerts, metrics, the screener
+// and exports) agrees on names, nesting and how numbers are read.
+package fields
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Field is a struct field reached from a root type.
+type Field struct {
+	Name  string       // JSON path, dotted below nested structs
+	Type  reflect.Type // with pointers removed
+	Index []int        // field indices from the root type
+}
+
+// Nested reports whether the field sits below a nested struct.
+func (f Field) Nested() bool {
+	return strings.Contains(f.Name, ".")
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+	cache sync.Map // reflect.Type -> []Field
+)
+
+// Of lists the exported fields of struct type t in declaration order. Nested
+// structs expand into dotted paths and embedded structs without a JSON name
+// are inlined. time.Time, types marshaling themselves and types already being
+// expanded, which would recurse, are single fields. The result is shared and
+// must not be modified.
+func Of(t reflect.Type) []Field {
+	if cached, ok := cache.Load(t); ok {
+		return cached.([]Field)
+	}
+	fields := walk(t, "", nil, map[reflect.Type]bool{})
+	cache.Store(t, fields)
+	return fields
+}
+
+func walk(t reflect.Type, prefix string, index []int, seen map[reflect.Type]bool) []Field {
+	seen[t] = true
+	defer delete(seen, t)
+
+	var fields []Field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+		idx := append(append([]int(nil), index...), i)
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && tag == "" && ft.Kind() == reflect.Struct {
+			fields = append(fields, walk(ft, prefix, idx, seen)...)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		name = prefix + name
+		if ft.Kind() == reflect.Struct && ft != timeType && !seen[ft] && !reflect.PointerTo(ft).Implements(marshalerType) {
+			fields = append(fields, walk(ft, name+".", idx, seen)...)
+			continue
+		}
+		fields = append(fields, Field{Name: name, Type: ft, Index: idx})
+	}
+	return fields
+}
+
+// Value reads field f of v, a struct or a pointer to one, with pointers
+// removed. It reports false when a pointer on the way is nil.
+func Value(v reflect.Value, f Field) (reflect.Value, bool) {
+	for _, i := range f.Index {
+		if v = deref(v); !v.IsValid() {
+			return v, false
+		}
+		v = v.Field(i)
+	}
+	v = deref(v)
+	return v, v.IsValid()
+}
+
+func deref(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// Numeric reports whether values of t read as numbers: integers, floats and
+// booleans.
+func Numeric(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// Number reads a numeric value as a float64; booleans read as 1 or 0.
+func Number(v reflect.Value) (float64, bool) {
+	switch {
+	case v.CanFloat():
+		return v.Float(), true
+	case v.CanInt():
+		return float64(v.Int()), true
+	case v.CanUint():
+		return float64(v.Uint()), true
+	case v.Kind() == reflect.Bool:
+		if v.Bool() {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// Numbers reads the top-level numeric fields of a struct or a pointer to one
+// by JSON name. Fields behind a nil pointer are left out.
+func Numbers(v any) map[string]float64 {
+	values := map[string]float64{}
+	rv := deref(reflect.ValueOf(v))
+	if !rv.IsValid() || rv.Kind() != reflect.Struct {
+		return values
+	}
+	for _, f := range Of(rv.Type()) {
+		if f.Nested() || !Numeric(f.Type) {
+			continue
+		}
+		if fv, ok := Value(rv, f); ok {
+			values[f.Name], _ = Number(fv)
+		}
+	}
+	return values
+}
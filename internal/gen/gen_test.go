@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// TestGenerateGolden generates testdata/spec.json and compares every file
+// with testdata/golden. Run with -update after an intended change.
+func TestGenerateGolden(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "spec.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec Spec
+	if err := json.Unmarshal(raw, &spec); err != nil {
+		t.Fatal(err)
+	}
+	files, err := Generate(&spec, "v2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := filepath.Join("testdata", "golden")
+	if *update {
+		old, _ := filepath.Glob(filepath.Join(dir, "*.golden"))
+		for _, path := range old {
+			os.Remove(path)
+		}
+		for name, data := range files {
+			if err := os.WriteFile(filepath.Join(dir, name+".golden"), data, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	golden, err := filepath.Glob(filepath.Join(dir, "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{}
+	for _, path := range golden {
+		name := filepath.Base(path)
+		name = name[:len(name)-len(".golden")]
+		want[name] = true
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, ok := files[name]; !ok {
+			t.Errorf("%s: not generated", name)
+		} else if !bytes.Equal(got, data) {
+			t.Errorf("%s differs from %s; run go test ./internal/gen -update and review the diff", name, path)
+		}
+	}
+	var extra []string
+	for name := range files {
+		if !want[name] {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		t.Errorf("%s: generated but has no golden file", name)
+	}
+}
@@ -0,0 +1,551 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// header marks every file written by the generator; files carrying it are
+// owned by the generator and are removed when they are no longer produced.
+const header = "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n"
+
+const banner = "// ========================\n"
+
+// Generate renders the Go sources for spec, keyed by file name.
+func Generate(spec *Spec, pkg string) (map[string][]byte, error) {
+	g := &generator{spec: spec, pkg: pkg, emitted: map[string]bool{}}
+	files := map[string][]byte{}
+
+	for _, s := range spec.Components.Schemas {
+		if s.Schema.GoFile != "" && !g.hasTag(s.Schema.GoFile) {
+			return nil, fmt.Errorf("schema %s: x-go-file %q is not a declared tag", s.Name, s.Schema.GoFile)
+		}
+	}
+
+	for _, tag := range g.tags() {
+		ops := g.operations(tag.Name)
+		if len(ops) == 0 {
+			continue
+		}
+		funcs, err := g.funcFile(tag, ops)
+		if err != nil {
+			return nil, err
+		}
+		types, err := g.typeFile(tag.Name, ops)
+		if err != nil {
+			return nil, err
+		}
+		files[tag.Name+".go"] = funcs
+		files[tag.Name+"Type.go"] = types
+	}
+
+	endpoints, err := g.endpointFile()
+	if err != nil {
+		return nil, err
+	}
+	files["endpoints.go"] = endpoints
+
+	models, err := g.modelFile()
+	if err != nil {
+		return nil, err
+	}
+	if models != nil {
+		files["types.go"] = models
+	}
+	return files, nil
+}
+
+type generator struct {
+	spec    *Spec
+	pkg     string
+	emitted map[string]bool
+}
+
+func (g *generator) hasTag(name string) bool {
+	for _, t := range g.tags() {
+		if t.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// tags returns the declared tags followed by any tag only used on operations.
+func (g *generator) tags() []Tag {
+	tags := append([]Tag(nil), g.spec.Tags...)
+	seen := map[string]bool{}
+	for _, t := range tags {
+		seen[t.Name] = true
+	}
+	for _, op := range g.spec.Paths {
+		if !seen[op.Tag()] {
+			seen[op.Tag()] = true
+			tags = append(tags, Tag{Name: op.Tag()})
+		}
+	}
+	return tags
+}
+
+func (g *generator) operations(tag string) []*Operation {
+	var ops []*Operation
+	for _, op := range g.spec.Paths {
+		if op.Tag() == tag {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// ========================
+// Endpoint functions
+// ========================
+
+func (g *generator) funcFile(tag Tag, ops []*Operation) ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]bool{"context": true}
+
+	body.WriteString("const (\n")
+	if tag.Description != "" {
+		fmt.Fprintf(&body, "\t// %s\n\n", tag.Description)
+	}
+	for _, op := range ops {
+		doc := op.ExternalDocs.URL
+		if doc == "" {
+			doc = op.Description
+		}
+		fmt.Fprintf(&body, "\t// %s %s\n\t%s = %q\n", constName(op), doc, constName(op), op.Path)
+	}
+	body.WriteString(")\n")
+
+	for _, op := range ops {
+		fn, err := g.function(op, imports)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", op.Method, op.Path, err)
+		}
+		body.WriteString("\n")
+		body.WriteString(fn)
+	}
+
+	return g.file(imports, body.Bytes())
+}
+
+func (g *generator) function(op *Operation, imports map[string]bool) (string, error) {
+	if op.OperationID == "" {
+		return "", fmt.Errorf("missing operationId")
+	}
+	resp := op.Response()
+	if resp == nil || resp.Ref == "" {
+		return "", fmt.Errorf("200 response must reference a component schema")
+	}
+	name := exported(op.OperationID)
+	respType := RefName(resp.Ref)
+
+	var b strings.Builder
+	if op.Description != "" {
+		fmt.Fprintf(&b, "// %s %s\n", name, op.Description)
+	}
+
+	switch op.Method {
+	case "GET":
+		imports["net/url"] = true
+		fmt.Fprintf(&b, "func %s(ctx context.Context, client HTTPClient, req *%sRequest) (*%s, error) {\n", name, constName(op), respType)
+		b.WriteString("\tparams := url.Values{}\n")
+		for _, p := range op.Parameters {
+			if p.In != "query" {
+				return "", fmt.Errorf("parameter %s: only query parameters are supported", p.Name)
+			}
+			code, err := g.setParam(p, imports)
+			if err != nil {
+				return "", fmt.Errorf("parameter %s: %w", p.Name, err)
+			}
+			b.WriteString(code)
+		}
+		fmt.Fprintf(&b, "\n\tvar resp %s\n", respType)
+		fmt.Fprintf(&b, "\tif err := client.Get(ctx, %s, params, &resp); err != nil {\n", constName(op))
+	case "POST":
+		if len(op.Parameters) > 0 {
+			return "", fmt.Errorf("POST operations take their input from the request body only")
+		}
+		reqSchema := op.Body()
+		if reqSchema == nil || reqSchema.Ref == "" {
+			return "", fmt.Errorf("request body must reference a component schema")
+		}
+		fmt.Fprintf(&b, "func %s(ctx context.Context, client HTTPPoster, req *%s) (*%s, error) {\n", name, RefName(reqSchema.Ref), respType)
+		fmt.Fprintf(&b, "\tvar resp %s\n", respType)
+		fmt.Fprintf(&b, "\tif err := client.Post(ctx, %s, req, &resp); err != nil {\n", constName(op))
+	default:
+		return "", fmt.Errorf("unsupported method %s", op.Method)
+	}
+	b.WriteString("\t\treturn nil, err\n\t}\n\n\treturn &resp, nil\n}\n")
+	return b.String(), nil
+}
+
+// setParam renders the statement copying one request field into params.
+func (g *generator) setParam(p Parameter, imports map[string]bool) (string, error) {
+	field := "req." + paramName(p)
+	s := p.Schema
+	if s == nil {
+		return "", fmt.Errorf("missing schema")
+	}
+	nullable := isPointer(s)
+	deref := field
+	if nullable {
+		deref = "*" + field
+	}
+
+	var value, zero string
+	switch {
+	case s.Ref != "":
+		target := g.spec.Components.Schemas.Lookup(RefName(s.Ref))
+		if target == nil || target.Type != "string" || len(target.Enum) == 0 {
+			return "", fmt.Errorf("only string enums may be referenced")
+		}
+		value, zero = "string("+deref+")", `""`
+	case s.Type == "string":
+		value, zero = deref, `""`
+	case s.Type == "integer" && s.Format == "int64":
+		imports["strconv"] = true
+		value, zero = "strconv.FormatInt("+deref+", 10)", "0"
+	case s.Type == "integer":
+		imports["strconv"] = true
+		value, zero = "strconv.Itoa("+deref+")", "0"
+	case s.Type == "number":
+		imports["strconv"] = true
+		value, zero = "strconv.FormatFloat("+deref+", 'f', -1, 64)", "0"
+	case s.Type == "boolean":
+		imports["strconv"] = true
+		value, zero = "strconv.FormatBool("+deref+")", "false"
+	case s.Type == "array" && s.Items != nil && s.Items.Type == "string":
+		imports["strings"] = true
+		value = `strings.Join(` + field + `, ",")`
+	default:
+		return "", fmt.Errorf("unsupported parameter type %q", s.Type)
+	}
+
+	set := fmt.Sprintf("params.Set(%q, %s)\n", p.Name, value)
+	switch {
+	case nullable:
+		return fmt.Sprintf("\tif %s != nil {\n\t\t%s\t}\n", field, set), nil
+	case p.Required:
+		return "\t" + set, nil
+	case s.Type == "array":
+		return fmt.Sprintf("\tif len(%s) > 0 {\n\t\t%s\t}\n", field, set), nil
+	case s.Type == "boolean":
+		return fmt.Sprintf("\tif %s {\n\t\t%s\t}\n", field, set), nil
+	default:
+		return fmt.Sprintf("\tif %s != %s {\n\t\t%s\t}\n", field, zero, set), nil
+	}
+}
+
+// ========================
+// Types
+// ========================
+
+func (g *generator) typeFile(tag string, ops []*Operation) ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]bool{}
+
+	for _, op := range ops {
+		title := op.Summary
+		if title == "" {
+			title = constName(op)
+		}
+		body.WriteString(banner)
+		fmt.Fprintf(&body, "// %s Types\n", title)
+		body.WriteString(banner)
+		body.WriteString("\n")
+
+		if op.Method == "GET" {
+			fields := make([]Property, 0, len(op.Parameters))
+			var required []string
+			for _, p := range op.Parameters {
+				s := *p.Schema
+				s.GoName = paramName(p)
+				if s.Description == "" {
+					s.Description = p.Description
+				}
+				fields = append(fields, Property{Name: p.Name, Schema: &s})
+				if p.Required {
+					required = append(required, p.Name)
+				}
+			}
+			req := &Schema{Type: "object", Properties: fields, Required: required}
+			if err := g.writeType(&body, constName(op)+"Request", req, imports, false, true); err != nil {
+				return nil, err
+			}
+		}
+		for _, s := range []*Schema{op.Body(), op.Response()} {
+			if s == nil || s.Ref == "" {
+				continue
+			}
+			name := RefName(s.Ref)
+			target := g.spec.Components.Schemas.Lookup(name)
+			if target == nil {
+				return nil, fmt.Errorf("%s: unknown schema %s", op.Path, name)
+			}
+			if target.GoFile != tag || g.emitted[name] {
+				continue
+			}
+			if err := g.writeType(&body, name, target, imports, false, len(target.Required) > 0); err != nil {
+				return nil, err
+			}
+		}
+		body.WriteString("\n")
+	}
+
+	for _, s := range g.spec.Components.Schemas {
+		if s.Schema.GoFile != tag || g.emitted[s.Name] {
+			continue
+		}
+		if err := g.writeType(&body, s.Name, s.Schema, imports, true, len(s.Schema.Required) > 0); err != nil {
+			return nil, err
+		}
+		body.WriteString("\n")
+	}
+
+	return g.file(imports, body.Bytes())
+}
+
+// endpointFile renders the Endpoints registry used by tools that need to
+// handle every endpoint generically, such as drift checking.
+func (g *generator) endpointFile() ([]byte, error) {
+	var body bytes.Buffer
+	body.WriteString("// Endpoints lists every generated endpoint in spec order.\n")
+	body.WriteString("var Endpoints = []Endpoint{\n")
+	for _, op := range g.spec.Paths {
+		resp := op.Response()
+		if resp == nil || resp.Ref == "" {
+			return nil, fmt.Errorf("%s %s: 200 response must reference a component schema", op.Method, op.Path)
+		}
+		fmt.Fprintf(&body, "\t{Name: %q, Method: %q, Path: %s, Response: func() interface{} { return new(%s) }},\n",
+			constName(op), op.Method, constName(op), RefName(resp.Ref))
+	}
+	body.WriteString("}\n")
+	return g.file(nil, body.Bytes())
+}
+
+func (g *generator) modelFile() ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]bool{}
+	for _, s := range g.spec.Components.Schemas {
+		if s.Schema.GoFile != "" {
+			continue
+		}
+		if err := g.writeType(&body, s.Name, s.Schema, imports, true, len(s.Schema.Required) > 0); err != nil {
+			return nil, err
+		}
+		body.WriteString("\n")
+	}
+	if body.Len() == 0 {
+		return nil, nil
+	}
+	return g.file(imports, body.Bytes())
+}
+
+// writeType renders a named type for s. With omitempty set, fields not listed
+// as required are tagged omitempty; requests set it, responses do not.
+func (g *generator) writeType(w *bytes.Buffer, name string, s *Schema, imports map[string]bool, doc, omitempty bool) error {
+	g.emitted[name] = true
+	if doc && s.Description != "" {
+		fmt.Fprintf(w, "// %s\n", s.Description)
+	}
+
+	if s.Type == "string" && len(s.Enum) > 0 {
+		fmt.Fprintf(w, "type %s string\n\nconst (\n", name)
+		for _, v := range s.Enum {
+			fmt.Fprintf(w, "\t%s%s %s = %q\n", name, exported(v), name, v)
+		}
+		w.WriteString(")\n")
+		return nil
+	}
+	if s.Type != "object" || s.AdditionalProperties != nil {
+		typ, err := g.goType(s, imports)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		fmt.Fprintf(w, "type %s %s\n", name, typ)
+		return nil
+	}
+
+	required := map[string]bool{}
+	for _, r := range s.Required {
+		required[r] = true
+	}
+	fmt.Fprintf(w, "type %s struct {\n", name)
+	for _, p := range s.Properties {
+		typ, err := g.goType(p.Schema, imports)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", name, p.Name, err)
+		}
+		tag := p.Name
+		if omitempty && !required[p.Name] {
+			tag += ",omitempty"
+		}
+		field := p.Schema.GoName
+		if field == "" {
+			field = exported(p.Name)
+		}
+		fmt.Fprintf(w, "\t%s %s `json:%q`", field, typ, tag)
+		if p.Schema.Description != "" {
+			fmt.Fprintf(w, " // %s", p.Schema.Description)
+		}
+		w.WriteString("\n")
+	}
+	w.WriteString("}\n")
+	return nil
+}
+
+func (g *generator) goType(s *Schema, imports map[string]bool) (string, error) {
+	ptr := func(t string) string {
+		if isPointer(s) {
+			return "*" + t
+		}
+		return t
+	}
+
+	if s.Ref != "" {
+		name := RefName(s.Ref)
+		if g.spec.Components.Schemas.Lookup(name) == nil {
+			return "", fmt.Errorf("unknown schema %s", name)
+		}
+		return name, nil
+	}
+	switch s.Type {
+	case "":
+		return "any", nil
+	case "string":
+		if s.Format == "date-time" {
+			imports["time"] = true
+			return "time.Time", nil
+		}
+		return "string", nil
+	case "integer":
+		if s.Format == "int64" {
+			return ptr("int64"), nil
+		}
+		return ptr("int"), nil
+	case "number":
+		return ptr("float64"), nil
+	case "boolean":
+		return ptr("bool"), nil
+	case "array":
+		if s.Items == nil {
+			return "[]any", nil
+		}
+		elem, err := g.goType(s.Items, imports)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object":
+		if s.AdditionalProperties != nil {
+			elem, err := g.goType(s.AdditionalProperties, imports)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + elem, nil
+		}
+		if len(s.Properties) == 0 {
+			return "map[string]any", nil
+		}
+		return "", fmt.Errorf("inline objects are not supported; move the schema to components")
+	}
+	return "", fmt.Errorf("unsupported type %q", s.Type)
+}
+
+// isPointer reports whether a nullable schema needs a pointer to tell null
+// apart from the zero value. Strings, dates and composites decode null as
+// their zero value and stay plain.
+func isPointer(s *Schema) bool {
+	if !s.Nullable || s.Ref != "" {
+		return false
+	}
+	return s.Type == "integer" || s.Type == "number" || s.Type == "boolean"
+}
+
+func (g *generator) file(imports map[string]bool, body []byte) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", g.pkg)
+	names := make([]string, 0, len(imports))
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	switch len(names) {
+	case 0:
+	case 1:
+		fmt.Fprintf(&b, "import %q\n\n", names[0])
+	default:
+		b.WriteString("import (\n")
+		for _, name := range names {
+			fmt.Fprintf(&b, "\t%q\n", name)
+		}
+		b.WriteString(")\n\n")
+	}
+	b.Write(bytes.TrimRight(body, "\n"))
+	b.WriteString("\n")
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, b.Bytes())
+	}
+	return out, nil
+}
+
+// ========================
+// Naming
+// ========================
+
+func constName(op *Operation) string {
+	if op.GoName != "" {
+		return op.GoName
+	}
+	return strings.TrimPrefix(exported(op.OperationID), "Get")
+}
+
+func paramName(p Parameter) string {
+	if p.GoName != "" {
+		return p.GoName
+	}
+	return exported(p.Name)
+}
+
+// exported turns a JSON name into a Go identifier the way the hand-written
+// models did: the first letter and any letter following a digit are upper
+// cased ("volume1hUSD" -> "Volume1HUSD"), separators start a new word, and a
+// trailing "Id" word becomes "ID".
+func exported(name string) string {
+	var b strings.Builder
+	upper := true
+	var prev rune
+	for _, r := range name {
+		switch {
+		case r == '_' || r == '-' || r == '.' || r == ' ' || r == ':' || r == '/':
+			upper = true
+			continue
+		case upper || unicode.IsDigit(prev):
+			b.WriteRune(unicode.ToUpper(r))
+		default:
+			b.WriteRune(r)
+		}
+		upper = false
+		prev = r
+	}
+	s := b.String()
+
+	var out strings.Builder
+	for i := 0; i < len(s); i++ {
+		if strings.HasPrefix(s[i:], "Id") && (i+2 == len(s) || unicode.IsUpper(rune(s[i+2])) || unicode.IsDigit(rune(s[i+2]))) {
+			out.WriteString("ID")
+			i++
+			continue
+		}
+		out.WriteByte(s[i])
+	}
+	return out.String()
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Spec is the subset of an OpenAPI 3 document understood by the generator.
+type Spec struct {
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Tags       []Tag `json:"tags"`
+	Paths      Paths `json:"paths"`
+	Components struct {
+		Schemas Schemas `json:"schemas"`
+	} `json:"components"`
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type Operation struct {
+	Method       string
+	Path         string
+	OperationID  string      `json:"operationId"`
+	GoName       string      `json:"x-go-name"`
+	Tags         []string    `json:"tags"`
+	Summary      string      `json:"summary"`
+	Description  string      `json:"description"`
+	Parameters   []Parameter `json:"parameters"`
+	ExternalDocs struct {
+		URL string `json:"url"`
+	} `json:"externalDocs"`
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *Schema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *Schema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required"`
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+	GoName      string  `json:"x-go-name"`
+}
+
+type Schema struct {
+	Ref                  string     `json:"$ref"`
+	Type                 string     `json:"type"`
+	Format               string     `json:"format"`
+	Nullable             bool       `json:"nullable"`
+	Description          string     `json:"description"`
+	Enum                 []string   `json:"enum"`
+	Items                *Schema    `json:"items"`
+	Properties           Properties `json:"properties"`
+	AdditionalProperties *Schema    `json:"additionalProperties"`
+	Required             []string   `json:"required"`
+	GoName               string     `json:"x-go-name"`
+	GoFile               string     `json:"x-go-file"`
+}
+
+// Property is a named schema; properties keep the order of the document so
+// that generated structs read like the spec.
+type Property struct {
+	Name   string
+	Schema *Schema
+}
+
+type Properties []Property
+
+func (p *Properties) UnmarshalJSON(data []byte) error {
+	return decodeOrdered(data, func(key string, dec *json.Decoder) error {
+		var s Schema
+		if err := dec.Decode(&s); err != nil {
+			return err
+		}
+		*p = append(*p, Property{Name: key, Schema: &s})
+		return nil
+	})
+}
+
+// Schemas are the named component schemas, in document order.
+type Schemas []Property
+
+func (s *Schemas) UnmarshalJSON(data []byte) error {
+	return (*Properties)(s).UnmarshalJSON(data)
+}
+
+func (s Schemas) Lookup(name string) *Schema {
+	for _, p := range s {
+		if p.Name == name {
+			return p.Schema
+		}
+	}
+	return nil
+}
+
+// Paths are the operations of the document, in document order.
+type Paths []*Operation
+
+func (p *Paths) UnmarshalJSON(data []byte) error {
+	return decodeOrdered(data, func(path string, dec *json.Decoder) error {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		return decodeOrdered(raw, func(method string, dec *json.Decoder) error {
+			var op Operation
+			if err := dec.Decode(&op); err != nil {
+				return err
+			}
+			op.Method = strings.ToUpper(method)
+			op.Path = path
+			*p = append(*p, &op)
+			return nil
+		})
+	})
+}
+
+// decodeOrdered walks the keys of a JSON object in order, handing the decoder
+// positioned at each value to fn.
+func decodeOrdered(data []byte, fn func(key string, dec *json.Decoder) error) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("expected object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("expected object key, got %v", tok)
+		}
+		if err := fn(key, dec); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// RefName returns the component name a $ref points to.
+func RefName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// Response returns the schema of the 200 JSON response.
+func (op *Operation) Response() *Schema {
+	r, ok := op.Responses["200"]
+	if !ok {
+		return nil
+	}
+	return r.Content["application/json"].Schema
+}
+
+// Body returns the schema of the JSON request body, if any.
+func (op *Operation) Body() *Schema {
+	if op.RequestBody == nil {
+		return nil
+	}
+	return op.RequestBody.Content["application/json"].Schema
+}
+
+func (op *Operation) Tag() string {
+	if len(op.Tags) == 0 {
+		return "default"
+	}
+	return op.Tags[0]
+}
@@ -0,0 +1,137 @@
+// Package labels classifies wallets in bulk. A Lookup deduplicates the
+// addresses it is given, fetches the unknown ones from the wallet labels
+// endpoint in batches and caches the results, so a list of trades can be
+// annotated with one call:
+//
+//	lookup := labels.New(client, &labels.Options{Blockchain: "solana"})
+//	byWallet, err := lookup.Get(ctx, traders)
+//	if labels.Has(byWallet[trader], v2.WalletLabelSniper) { ... }
+package labels
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// DefaultTTL is how long labels are cached by default.
+const DefaultTTL = time.Hour
+
+// maxBatch is the most wallets the labels endpoint accepts per request.
+const maxBatch = 100
+
+// Options tune a Lookup.
+type Options struct {
+	Blockchain string        // restrict labels to one chain
+	TTL        time.Duration // cache lifetime, default DefaultTTL; negative disables caching
+	BatchSize  int           // wallets per request, default and max 100
+}
+
+type entry struct {
+	data    v2.WalletLabelsData
+	fetched time.Time
+}
+
+// Lookup fetches and caches wallet labels. It is safe for concurrent use.
+type Lookup struct {
+	client     v2.HTTPClient
+	blockchain string
+	ttl        time.Duration
+	batch      int
+
+	mu    sync.Mutex
+	cache map[string]entry
+}
+
+// New creates a Lookup reading through client.
+func New(client v2.HTTPClient, opts *Options) *Lookup {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.TTL == 0 {
+		o.TTL = DefaultTTL
+	}
+	if o.BatchSize <= 0 || o.BatchSize > maxBatch {
+		o.BatchSize = maxBatch
+	}
+	return &Lookup{client: client, blockchain: o.Blockchain, ttl: o.TTL, batch: o.BatchSize, cache: map[string]entry{}}
+}
+
+// Get returns the labels of every wallet, keyed by the addresses as given.
+// Wallets the API knows nothing about map to a value without labels. When a
+// batch fails, Get returns the labels it has along with the error; batches
+// fetched before are cached, so calling again only requests the rest.
+func (l *Lookup) Get(ctx context.Context, wallets []string) (map[string]v2.WalletLabelsData, error) {
+	now := time.Now()
+	out := make(map[string]v2.WalletLabelsData, len(wallets))
+	var missing []string
+	pending := map[string]bool{}
+
+	l.mu.Lock()
+	for _, w := range wallets {
+		key := address.Normalize(w)
+		if e, ok := l.cache[key]; ok && now.Sub(e.fetched) < l.ttl {
+			out[w] = e.data
+			continue
+		}
+		if !pending[key] {
+			pending[key] = true
+			missing = append(missing, w)
+		}
+	}
+	l.mu.Unlock()
+
+	fetched := map[string]v2.WalletLabelsData{}
+	var err error
+	for start := 0; start < len(missing); start += l.batch {
+		chunk := missing[start:min(start+l.batch, len(missing))]
+		var resp *v2.WalletLabelsResponse
+		resp, err = v2.GetWalletLabels(ctx, l.client, &v2.WalletLabelsRequest{Wallets: chunk, Blockchain: l.blockchain})
+		if err != nil {
+			break
+		}
+		for _, d := range resp.Data {
+			fetched[address.Normalize(d.Wallet)] = d
+		}
+		for _, w := range chunk {
+			if _, ok := fetched[address.Normalize(w)]; !ok {
+				fetched[address.Normalize(w)] = v2.WalletLabelsData{Wallet: w, Labels: []v2.WalletLabel{}}
+			}
+		}
+	}
+
+	l.mu.Lock()
+	for key, d := range fetched {
+		if l.ttl > 0 {
+			l.cache[key] = entry{data: d, fetched: now}
+		}
+	}
+	l.mu.Unlock()
+	for _, w := range wallets {
+		if d, ok := fetched[address.Normalize(w)]; ok {
+			out[w] = d
+		}
+	}
+	return out, err
+}
+
+// Purge empties the cache.
+func (l *Lookup) Purge() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.cache = map[string]entry{}
+}
+
+// Has reports whether d carries label.
+func Has(d v2.WalletLabelsData, label v2.WalletLabel) bool {
+	for _, l := range d.Labels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+package labels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// flakyClient labels every wallet as a sniper and fails the requests listed
+// in fail, counting from 1.
+type flakyClient struct {
+	requests [][]string
+	fail     map[int]bool
+}
+
+func (c *flakyClient) Get(_ context.Context, _ string, params url.Values, result interface{}) error {
+	wallets := strings.Split(params.Get("wallets"), ",")
+	c.requests = append(c.requests, wallets)
+	if c.fail[len(c.requests)] {
+		return errors.New("connection reset")
+	}
+	var data []v2.WalletLabelsData
+	for _, w := range wallets {
+		data = append(data, v2.WalletLabelsData{Wallet: w, Labels: []v2.WalletLabel{v2.WalletLabelSniper}})
+	}
+	result.(*v2.WalletLabelsResponse).Data = data
+	return nil
+}
+
+func TestGetPartialFailure(t *testing.T) {
+	var wallets []string
+	for i := range 5 {
+		wallets = append(wallets, fmt.Sprintf("0x%040d", i))
+	}
+	client := &flakyClient{fail: map[int]bool{2: true}}
+	l := New(client, &Options{BatchSize: 2})
+
+	got, err := l.Get(context.Background(), wallets)
+	if err == nil {
+		t.Fatal("failed batch not reported")
+	}
+	if len(got) != 2 || !Has(got[wallets[0]], v2.WalletLabelSniper) || !Has(got[wallets[1]], v2.WalletLabelSniper) {
+		t.Errorf("partial result %v, want the first batch", got)
+	}
+
+	// The first batch is cached, so only the rest is requested again.
+	client.requests, client.fail = nil, nil
+	got, err = l.Get(context.Background(), wallets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(wallets) {
+		t.Errorf("%d wallets labelled, want %d", len(got), len(wallets))
+	}
+	if want := [][]string{wallets[2:4], wallets[4:]}; !reflect.DeepEqual(client.requests, want) {
+		t.Errorf("requests %v, want %v", client.requests, want)
+	}
+}
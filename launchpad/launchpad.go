@@ -0,0 +1,259 @@
+// Package launchpad tracks tokens launched on bonding curves through their
+// lifecycle: created, filling the curve, bonded and migrated to a regular
+// pool, or dead.
+//
+// A Tracker turns token snapshots into lifecycle events. Snapshots can come
+// from any source: Run polls the token details endpoint, and a streaming
+// feed can call Observe directly. Time is read from a clock.Clock, so a
+// clock.Fake makes the tracker deterministic.
+package launchpad
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/clock"
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// EventKind classifies an Event.
+type EventKind string
+
+const (
+	// EventCreated is the first snapshot of a token.
+	EventCreated EventKind = "created"
+	// EventProgress is the bonding curve filling past a threshold.
+	EventProgress EventKind = "progress"
+	// EventBonded is the curve completing and the token migrating to a pool.
+	EventBonded EventKind = "bonded"
+	// EventDead is a token without trades for longer than DeadAfter, counted
+	// from its first snapshot when it never traded.
+	EventDead EventKind = "dead"
+)
+
+// TokenRef identifies a token.
+type TokenRef struct {
+	Address    string
+	Blockchain string
+}
+
+// Event is one lifecycle transition.
+type Event struct {
+	Kind  EventKind
+	Token TokenRef
+	Time  time.Time // clock time the transition was detected
+	// BondingPercentage is the curve progress in the snapshot; Threshold is
+	// the progress threshold crossed, for EventProgress.
+	BondingPercentage float64
+	Threshold         float64
+	// PoolAddress is the pool the token migrated to and CurveAddress its
+	// bonding curve, for EventBonded.
+	PoolAddress  string
+	CurveAddress string
+	BondedAt     time.Time
+	// LastTrade is the latest trade seen, for EventDead; zero when the token
+	// never traded.
+	LastTrade time.Time
+}
+
+// Options tune a Tracker.
+type Options struct {
+	Clock      clock.Clock   // default clock.System
+	Thresholds []float64     // bonding percentages reported once crossed, default 25, 50, 75, 90
+	DeadAfter  time.Duration // default 30 minutes; negative disables dead detection
+}
+
+type state struct {
+	created   bool
+	firstSeen time.Time // clock time of the first snapshot
+	progress  float64   // highest bonding percentage seen
+	bonded    bool
+	lastTrade time.Time
+	dead      bool
+}
+
+// Tracker turns snapshots of watched tokens into lifecycle events. It is
+// safe for concurrent use.
+type Tracker struct {
+	clock      clock.Clock
+	thresholds []float64
+	deadAfter  time.Duration
+
+	mu     sync.Mutex
+	tokens map[TokenRef]*state
+}
+
+// NewTracker creates a tracker watching no token.
+func NewTracker(opts *Options) *Tracker {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if o.Thresholds == nil {
+		o.Thresholds = []float64{25, 50, 75, 90}
+	}
+	if o.DeadAfter == 0 {
+		o.DeadAfter = 30 * time.Minute
+	}
+	thresholds := append([]float64(nil), o.Thresholds...)
+	sort.Float64s(thresholds)
+	return &Tracker{clock: o.Clock, thresholds: thresholds, deadAfter: o.DeadAfter, tokens: map[TokenRef]*state{}}
+}
+
+// Watch adds a token. Snapshots of tokens not watched are ignored.
+func (t *Tracker) Watch(address, blockchain string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ref := refOf(address, blockchain)
+	if t.tokens[ref] == nil {
+		t.tokens[ref] = &state{}
+	}
+}
+
+// Unwatch removes a token and forgets its state.
+func (t *Tracker) Unwatch(address, blockchain string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.tokens, refOf(address, blockchain))
+}
+
+// Watched returns the watched tokens.
+func (t *Tracker) Watched() []TokenRef {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	refs := make([]TokenRef, 0, len(t.tokens))
+	for ref := range t.tokens {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Blockchain != refs[j].Blockchain {
+			return refs[i].Blockchain < refs[j].Blockchain
+		}
+		return refs[i].Address < refs[j].Address
+	})
+	return refs
+}
+
+// Observe records a snapshot of a token and returns the transitions it
+// reveals, oldest first. A token seen for the first time already bonded
+// yields both EventCreated and EventBonded, but no progress events.
+func (t *Tracker) Observe(tok *v2.Token) []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ref := refOf(tok.Address, tok.Blockchain)
+	s := t.tokens[ref]
+	if s == nil {
+		return nil
+	}
+	now := t.clock.Now()
+	event := func(kind EventKind) Event {
+		return Event{Kind: kind, Token: ref, Time: now, BondingPercentage: tok.BondingPercentage}
+	}
+
+	var events []Event
+	if !s.created {
+		s.created = true
+		s.firstSeen = now
+		events = append(events, event(EventCreated))
+		if tok.Bonded {
+			s.progress = 100
+		}
+	}
+	if !s.bonded {
+		for _, th := range t.thresholds {
+			if s.progress < th && tok.BondingPercentage >= th {
+				e := event(EventProgress)
+				e.Threshold = th
+				events = append(events, e)
+			}
+		}
+		s.progress = max(s.progress, tok.BondingPercentage)
+	}
+	if tok.Bonded && !s.bonded {
+		s.bonded = true
+		e := event(EventBonded)
+		e.PoolAddress = tok.PoolAddress
+		e.CurveAddress = tok.BondingCurveAddress
+		e.BondedAt = tok.BondedAt
+		events = append(events, e)
+	}
+	if tok.LatestTradeDate.After(s.lastTrade) {
+		s.lastTrade = tok.LatestTradeDate
+		s.dead = false
+	}
+	return append(events, t.checkDead(ref, s, now)...)
+}
+
+// Check reports the watched tokens that died since the last call, for feeds
+// that stop sending snapshots of tokens nobody trades.
+func (t *Tracker) Check() []Event {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.clock.Now()
+	var events []Event
+	for ref, s := range t.tokens {
+		events = append(events, t.checkDead(ref, s, now)...)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Token.Address < events[j].Token.Address })
+	return events
+}
+
+func (t *Tracker) checkDead(ref TokenRef, s *state, now time.Time) []Event {
+	if t.deadAfter < 0 || s.dead || !s.created {
+		return nil
+	}
+	since := s.lastTrade
+	if since.IsZero() {
+		since = s.firstSeen
+	}
+	if now.Sub(since) <= t.deadAfter {
+		return nil
+	}
+	s.dead = true
+	return []Event{{Kind: EventDead, Token: ref, Time: now, BondingPercentage: s.progress, LastTrade: s.lastTrade}}
+}
+
+// Run polls the token details of every watched token each interval and
+// passes the resulting events to emit, until ctx is done. A failed poll of a
+// token is passed to onError when set and retried on the next round.
+func (t *Tracker) Run(ctx context.Context, client v2.HTTPClient, interval time.Duration, emit func(Event), onError func(TokenRef, error)) error {
+	for {
+		for _, ref := range t.Watched() {
+			resp, err := v2.GetTokenDetails(ctx, client, &v2.TokenDetailsRequest{Address: ref.Address, Blockchain: ref.Blockchain})
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if onError != nil {
+					onError(ref, err)
+				}
+				continue
+			}
+			snapshot := resp.Data
+			snapshot.Address, snapshot.Blockchain = ref.Address, ref.Blockchain
+			for _, e := range t.Observe(&snapshot) {
+				emit(e)
+			}
+		}
+		for _, e := range t.Check() {
+			emit(e)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.clock.After(interval):
+		}
+	}
+}
+
+func refOf(addr, blockchain string) TokenRef {
+	return TokenRef{Address: address.Normalize(addr), Blockchain: strings.ToLower(blockchain)}
+}
@@ -0,0 +1,292 @@
+package launchpad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/clock"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+const (
+	addr  = "0xAbC0000000000000000000000000000000000001"
+	chain = "Base"
+)
+
+var ref = TokenRef{Address: "0xabc0000000000000000000000000000000000001", Blockchain: "base"}
+
+func snapshot(progress float64, lastTrade time.Time) *v2.Token {
+	return &v2.Token{Address: addr, Blockchain: chain, BondingPercentage: progress, LatestTradeDate: lastTrade}
+}
+
+func kinds(events []Event) []string {
+	var out []string
+	for _, e := range events {
+		s := string(e.Kind)
+		if e.Kind == EventProgress {
+			s += fmt.Sprintf(":%g", e.Threshold)
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func TestObserveLifecycle(t *testing.T) {
+	clk := clock.NewFake(start)
+	tr := NewTracker(&Options{Clock: clk})
+	tr.Watch(addr, chain)
+
+	steps := []struct {
+		tok  *v2.Token
+		want []string
+	}{
+		{snapshot(10, start), []string{"created"}},
+		{snapshot(20, start), nil},
+		{snapshot(55, start), []string{"progress:25", "progress:50"}},
+		{snapshot(40, start), nil},
+		{snapshot(60, start), nil},
+		{snapshot(91, start), []string{"progress:75", "progress:90"}},
+	}
+	for i, step := range steps {
+		if got := kinds(tr.Observe(step.tok)); !reflect.DeepEqual(got, step.want) {
+			t.Errorf("step %d: events %v, want %v", i, got, step.want)
+		}
+	}
+
+	bondedAt := start.Add(time.Minute)
+	tok := snapshot(100, bondedAt)
+	tok.Bonded = true
+	tok.BondedAt = bondedAt
+	tok.PoolAddress = "0xpool"
+	tok.BondingCurveAddress = "0xcurve"
+	clk.Advance(time.Minute)
+	events := tr.Observe(tok)
+	want := Event{
+		Kind: EventBonded, Token: ref, Time: bondedAt, BondingPercentage: 100,
+		PoolAddress: "0xpool", CurveAddress: "0xcurve", BondedAt: bondedAt,
+	}
+	if len(events) != 1 || !reflect.DeepEqual(events[0], want) {
+		t.Errorf("bonding events %+v, want %+v", events, want)
+	}
+	if events := tr.Observe(tok); len(events) != 0 {
+		t.Errorf("bonded token observed again: %v", kinds(events))
+	}
+}
+
+func TestObserveFirstSeenBonded(t *testing.T) {
+	tr := NewTracker(&Options{Clock: clock.NewFake(start)})
+	tr.Watch(addr, chain)
+	tok := snapshot(100, start)
+	tok.Bonded = true
+	if got, want := kinds(tr.Observe(tok)), []string{"created", "bonded"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("events %v, want %v", got, want)
+	}
+}
+
+func TestObserveUnwatched(t *testing.T) {
+	tr := NewTracker(&Options{Clock: clock.NewFake(start)})
+	if events := tr.Observe(snapshot(50, start)); events != nil {
+		t.Errorf("unwatched token yields %v", kinds(events))
+	}
+
+	tr.Watch("0xABC0000000000000000000000000000000000001", "base")
+	tr.Watch(addr, chain)
+	if got := tr.Watched(); !reflect.DeepEqual(got, []TokenRef{ref}) {
+		t.Errorf("watched %v, want only %v", got, ref)
+	}
+	if got := kinds(tr.Observe(snapshot(0, start))); !reflect.DeepEqual(got, []string{"created"}) {
+		t.Errorf("events %v, want created", got)
+	}
+
+	tr.Unwatch(addr, chain)
+	if events := tr.Observe(snapshot(50, start)); events != nil {
+		t.Errorf("unwatched token yields %v", kinds(events))
+	}
+}
+
+func TestDead(t *testing.T) {
+	clk := clock.NewFake(start)
+	tr := NewTracker(&Options{Clock: clk, DeadAfter: 10 * time.Minute})
+	tr.Watch(addr, chain)
+	tr.Observe(snapshot(30, start))
+
+	clk.Advance(10 * time.Minute)
+	if events := tr.Check(); len(events) != 0 {
+		t.Errorf("dead at exactly DeadAfter: %v", kinds(events))
+	}
+	clk.Advance(time.Second)
+	events := tr.Check()
+	want := Event{Kind: EventDead, Token: ref, Time: clk.Now(), BondingPercentage: 30, LastTrade: start}
+	if len(events) != 1 || !reflect.DeepEqual(events[0], want) {
+		t.Fatalf("events %+v, want %+v", events, want)
+	}
+	if events := tr.Check(); len(events) != 0 {
+		t.Errorf("dead reported twice: %v", kinds(events))
+	}
+	if events := tr.Observe(snapshot(30, start)); len(events) != 0 {
+		t.Errorf("stale snapshot of a dead token yields %v", kinds(events))
+	}
+
+	trade := clk.Now()
+	if events := tr.Observe(snapshot(30, trade)); len(events) != 0 {
+		t.Errorf("revived token yields %v", kinds(events))
+	}
+	clk.Advance(11 * time.Minute)
+	events = tr.Check()
+	if len(events) != 1 || !events[0].LastTrade.Equal(trade) {
+		t.Errorf("events after revival %+v, want one dead since %s", events, trade)
+	}
+
+	// A token that never trades dies DeadAfter after its first snapshot.
+	tr = NewTracker(&Options{Clock: clk, DeadAfter: 10 * time.Minute})
+	tr.Watch(addr, chain)
+	clk.Advance(time.Hour)
+	if events := tr.Check(); len(events) != 0 {
+		t.Errorf("token never observed yields %v", kinds(events))
+	}
+	created := clk.Now()
+	if events := tr.Observe(snapshot(0, time.Time{})); !reflect.DeepEqual(kinds(events), []string{"created"}) {
+		t.Errorf("first snapshot yields %v, want created", kinds(events))
+	}
+	clk.Advance(10 * time.Minute)
+	if events := tr.Observe(snapshot(0, time.Time{})); len(events) != 0 {
+		t.Errorf("never traded token dead at exactly DeadAfter: %v", kinds(events))
+	}
+	clk.Advance(time.Second)
+	events = tr.Check()
+	want = Event{Kind: EventDead, Token: ref, Time: created.Add(10*time.Minute + time.Second)}
+	if len(events) != 1 || !reflect.DeepEqual(events[0], want) {
+		t.Errorf("events %+v, want %+v", events, want)
+	}
+	if events := tr.Observe(snapshot(0, clk.Now())); len(events) != 0 {
+		t.Errorf("first trade yields %v", kinds(events))
+	}
+	clk.Advance(11 * time.Minute)
+	if events := tr.Check(); len(events) != 1 || !events[0].LastTrade.Equal(created.Add(10*time.Minute+time.Second)) {
+		t.Errorf("events after the first trade %+v, want one dead since it", events)
+	}
+}
+
+func TestDeadDisabled(t *testing.T) {
+	clk := clock.NewFake(start)
+	tr := NewTracker(&Options{Clock: clk, DeadAfter: -1})
+	tr.Watch(addr, chain)
+	tr.Observe(snapshot(30, start))
+	clk.Advance(24 * time.Hour)
+	if events := tr.Check(); len(events) != 0 {
+		t.Errorf("dead detection disabled but got %v", kinds(events))
+	}
+}
+
+// stubClient answers token details from a map keyed by address.
+type stubClient struct {
+	mu     sync.Mutex
+	tokens map[string]*v2.Token
+}
+
+func (c *stubClient) set(address string, tok *v2.Token) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tokens[address] = tok
+}
+
+func (c *stubClient) Get(_ context.Context, path string, params url.Values, result interface{}) error {
+	if path != v2.TokenDetails {
+		return errors.New("unexpected path " + path)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tok := c.tokens[params.Get("address")]
+	if tok == nil {
+		return errors.New("token not found")
+	}
+	result.(*v2.TokenDetailsResponse).Data = *tok
+	return nil
+}
+
+// waitBlocked waits until Run is blocked on the clock between rounds.
+func waitBlocked(t *testing.T, clk *clock.Fake) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for clk.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Run never waited for the next round")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRun(t *testing.T) {
+	clk := clock.NewFake(start)
+	tr := NewTracker(&Options{Clock: clk})
+	tr.Watch(addr, chain)
+	tr.Watch("0xmissing", chain)
+
+	client := &stubClient{tokens: map[string]*v2.Token{ref.Address: {BondingPercentage: 30, LatestTradeDate: start}}}
+	var (
+		mu     sync.Mutex
+		events []Event
+		failed []TokenRef
+	)
+	emit := func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		events = append(events, e)
+	}
+	onError := func(ref TokenRef, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		failed = append(failed, ref)
+	}
+	take := func() ([]string, []TokenRef) {
+		mu.Lock()
+		defer mu.Unlock()
+		got, errs := kinds(events), failed
+		events, failed = nil, nil
+		return got, errs
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tr.Run(ctx, client, time.Minute, emit, onError) }()
+
+	waitBlocked(t, clk)
+	got, errs := take()
+	if want := []string{"created", "progress:25"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("round 1 events %v, want %v", got, want)
+	}
+	if want := []TokenRef{{Address: "0xmissing", Blockchain: "base"}}; !reflect.DeepEqual(errs, want) {
+		t.Errorf("round 1 failures %v, want %v", errs, want)
+	}
+
+	client.set(ref.Address, &v2.Token{BondingPercentage: 100, Bonded: true, PoolAddress: "0xpool", LatestTradeDate: start})
+	clk.Advance(time.Minute)
+	waitBlocked(t, clk)
+	if got, _ := take(); !reflect.DeepEqual(got, []string{"progress:50", "progress:75", "progress:90", "bonded"}) {
+		t.Errorf("round 2 events %v, want the remaining thresholds then bonded", got)
+	}
+
+	clk.Advance(31 * time.Minute)
+	waitBlocked(t, clk)
+	if got, _ := take(); !reflect.DeepEqual(got, []string{"dead"}) {
+		t.Errorf("round 3 events %v, want dead", got)
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Run returned %v, want context.Canceled", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
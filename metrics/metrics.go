@@ -0,0 +1,320 @@
+// Package metrics exposes token and pool data as Prometheus gauges.
+//
+// An Exporter periodically fetches the tokens and pools of a Config and
+// serves the latest values on an http.Handler in the Prometheus text format:
+//
+//	cfg, err := metrics.ParseConfig(strings.NewReader(`{
+//		"tokens": [{"address": "0x6982508145454ce325ddbe47a25d4ec3d2311933", "blockchain": "ethereum"}],
+//		"pools": [{"address": "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f", "blockchain": "ethereum"}],
+//		"security": true
+//	}`))
+//	e := metrics.New(client, cfg, nil)
+//	go e.Run(ctx, 30*time.Second, func(err error) { log.Print(err) })
+//	http.Handle("/metrics", e)
+//
+// The exporter only depends on v2.HTTPClient, so the handler can be
+// exercised offline with a fake client or a client pointed at a local
+// server.
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/clock"
+	"github.com/zomvs/mobula-go-sdk/internal/fields"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Target is a token or pool to export.
+type Target struct {
+	Address    string `json:"address"`
+	Blockchain string `json:"blockchain"`
+}
+
+// Config lists what an Exporter fetches.
+type Config struct {
+	Tokens []Target `json:"tokens"` // fetched with GetTokenDetails
+	Pools  []Target `json:"pools"`  // fetched with GetMarketDetails
+	// Security also fetches GetTokenSecurity for every token, for the buy
+	// and sell fees.
+	Security bool `json:"security,omitempty"`
+}
+
+// ParseConfig reads a JSON config.
+func ParseConfig(r io.Reader) (*Config, error) {
+	var cfg Config
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("metrics: parsing config: %w", err)
+	}
+	for _, t := range append(cfg.Tokens, cfg.Pools...) {
+		if t.Address == "" {
+			return nil, errors.New("metrics: config target has no address")
+		}
+	}
+	return &cfg, nil
+}
+
+// Options tune an Exporter.
+type Options struct {
+	Concurrency int         // targets fetched at once, default 4
+	Clock       clock.Clock // default clock.System
+}
+
+// windows are the volume and fee windows of the v2 models, as they appear
+// in their JSON field names.
+var windows = []string{"1min", "5min", "15min", "1h", "4h", "6h", "12h", "24h"}
+
+var descs = []desc{
+	{name: "mobula_token_price_usd", help: "Token price in USD."},
+	{name: "mobula_token_liquidity_usd", help: "Token liquidity across its pools in USD."},
+	{name: "mobula_token_market_cap_usd", help: "Token market cap in USD."},
+	{name: "mobula_token_volume_usd", help: "Token trading volume over the window in USD."},
+	{name: "mobula_token_fees_paid_usd", help: "Fees paid trading the token over the window in USD."},
+	{name: "mobula_token_holders", help: "Number of token holders."},
+	{name: "mobula_token_top10_holdings_percent", help: "Share of the supply held by the top 10 holders."},
+	{name: "mobula_token_buy_fee_percent", help: "Token tax on buys."},
+	{name: "mobula_token_sell_fee_percent", help: "Token tax on sells."},
+	{name: "mobula_pool_price_usd", help: "Pool price of the base token in USD."},
+	{name: "mobula_pool_liquidity_usd", help: "Pool liquidity in USD."},
+	{name: "mobula_pool_volume_usd", help: "Pool trading volume over the window in USD."},
+	{name: "mobula_pool_fees_paid_usd", help: "Fees paid trading in the pool over the window in USD."},
+	{name: "mobula_up", help: "Whether the last fetch of the target succeeded."},
+	{name: "mobula_last_success_timestamp_seconds", help: "Unix time of the last successful fetch of the target."},
+	{name: "mobula_fetch_errors_total", help: "Failed fetches of the target.", counter: true},
+}
+
+type targetKey struct {
+	kind string // token or pool
+	Target
+}
+
+func (k targetKey) labels() []Label {
+	return []Label{{"kind", k.kind}, {"blockchain", k.Blockchain}, {"address", k.Address}}
+}
+
+type targetState struct {
+	samples     []Sample
+	up          bool
+	lastSuccess time.Time
+	errors      int
+}
+
+// Exporter fetches a Config and serves it as Prometheus metrics. It is safe
+// for concurrent use.
+type Exporter struct {
+	client v2.HTTPClient
+	cfg    Config
+	opts   Options
+
+	mu    sync.Mutex
+	state map[targetKey]*targetState
+}
+
+// New creates an exporter for cfg. It serves no values until the first
+// Refresh.
+func New(client v2.HTTPClient, cfg *Config, opts *Options) *Exporter {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 4
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	return &Exporter{client: client, cfg: *cfg, opts: o, state: map[targetKey]*targetState{}}
+}
+
+// Refresh fetches every target once. A target any of whose requests fails
+// loses all its samples, so dashboards show a gap rather than stale or
+// partial values, and mobula_up drops to 0. The returned error joins the
+// failures.
+func (e *Exporter) Refresh(ctx context.Context) error {
+	var keys []targetKey
+	for _, t := range e.cfg.Tokens {
+		keys = append(keys, targetKey{"token", t})
+	}
+	for _, t := range e.cfg.Pools {
+		keys = append(keys, targetKey{"pool", t})
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, e.opts.Concurrency)
+	for _, k := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() { <-sem; wg.Done() }()
+			samples, err := e.fetch(ctx, k)
+			e.record(k, samples, err)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("metrics: %s %s on %s: %w", k.kind, k.Address, k.Blockchain, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// Run refreshes every interval until ctx is done, reporting failures to
+// onError when it is not nil.
+func (e *Exporter) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	for {
+		if err := e.Refresh(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-e.opts.Clock.After(interval):
+		}
+	}
+}
+
+func (e *Exporter) record(k targetKey, samples []Sample, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	st := e.state[k]
+	if st == nil {
+		st = &targetState{}
+		e.state[k] = st
+	}
+	st.samples = samples
+	st.up = err == nil
+	if err == nil {
+		st.lastSuccess = e.opts.Clock.Now()
+	} else {
+		st.errors++
+	}
+}
+
+// Samples returns the current values.
+func (e *Exporter) Samples() []Sample {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var samples []Sample
+	for k, st := range e.state {
+		samples = append(samples, st.samples...)
+		up := 0.0
+		if st.up {
+			up = 1
+		}
+		samples = append(samples,
+			Sample{"mobula_up", k.labels(), up},
+			Sample{"mobula_fetch_errors_total", k.labels(), float64(st.errors)},
+		)
+		if !st.lastSuccess.IsZero() {
+			samples = append(samples, Sample{"mobula_last_success_timestamp_seconds", k.labels(),
+				float64(st.lastSuccess.UnixMilli()) / 1000})
+		}
+	}
+	return samples
+}
+
+// WriteText writes the current values in the Prometheus text format.
+func (e *Exporter) WriteText(w io.Writer) error {
+	return writeText(w, descs, e.Samples())
+}
+
+// ServeHTTP serves the current values, typically on /metrics.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	e.WriteText(w)
+}
+
+func (e *Exporter) fetch(ctx context.Context, k targetKey) ([]Sample, error) {
+	if k.kind == "pool" {
+		resp, err := v2.GetMarketDetails(ctx, e.client, &v2.MarketDetailsRequest{
+			Address: k.Address, Blockchain: k.Blockchain,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return poolSamples(k, &resp.Data), nil
+	}
+
+	resp, err := v2.GetTokenDetails(ctx, e.client, &v2.TokenDetailsRequest{
+		Address: k.Address, Blockchain: k.Blockchain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	samples := tokenSamples(k, &resp.Data)
+	if !e.cfg.Security {
+		return samples, nil
+	}
+
+	sec, err := v2.GetTokenSecurity(ctx, e.client, &v2.TokenSecurityRequest{
+		Address: k.Address, Blockchain: k.Blockchain,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("security: %w", err)
+	}
+	labels := tokenLabels(k, &resp.Data)
+	return append(samples,
+		Sample{"mobula_token_buy_fee_percent", labels, sec.Data.BuyFeePercentage},
+		Sample{"mobula_token_sell_fee_percent", labels, sec.Data.SellFeePercentage},
+	), nil
+}
+
+func tokenLabels(k targetKey, t *v2.Token) []Label {
+	return []Label{{"blockchain", k.Blockchain}, {"address", k.Address}, {"symbol", t.Symbol}}
+}
+
+func tokenSamples(k targetKey, t *v2.Token) []Sample {
+	labels := tokenLabels(k, t)
+	samples := []Sample{
+		{"mobula_token_price_usd", labels, t.PriceUSD},
+		{"mobula_token_liquidity_usd", labels, t.LiquidityUSD},
+		{"mobula_token_market_cap_usd", labels, t.MarketCapUSD},
+		{"mobula_token_holders", labels, float64(t.HoldersCount)},
+		{"mobula_token_top10_holdings_percent", labels, t.Top10HoldingsPercentage},
+	}
+	return append(samples, windowed("mobula_token", labels, fields.Numbers(t))...)
+}
+
+func poolSamples(k targetKey, m *v2.Market) []Sample {
+	labels := []Label{
+		{"blockchain", k.Blockchain},
+		{"address", k.Address},
+		{"pair", m.Base.Symbol + "/" + m.Quote.Symbol},
+		{"exchange", m.Exchange.Name},
+	}
+	samples := []Sample{
+		{"mobula_pool_price_usd", labels, m.PriceUSD},
+		{"mobula_pool_liquidity_usd", labels, m.LiquidityUSD},
+	}
+	return append(samples, windowed("mobula_pool", labels, fields.Numbers(m))...)
+}
+
+// windowed reads the volume<window>USD and feesPaid<window>USD fields into
+// samples labelled by window.
+func windowed(prefix string, labels []Label, values map[string]float64) []Sample {
+	var samples []Sample
+	for _, w := range windows {
+		wl := append(labels[:len(labels):len(labels)], Label{"window", w})
+		if v, ok := values["volume"+w+"USD"]; ok {
+			samples = append(samples, Sample{prefix + "_volume_usd", wl, v})
+		}
+		if v, ok := values["feesPaid"+w+"USD"]; ok {
+			samples = append(samples, Sample{prefix + "_fees_paid_usd", wl, v})
+		}
+	}
+	return samples
+}
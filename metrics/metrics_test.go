@@ -0,0 +1,134 @@
+package metrics
+
+import (
+	"context"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/clock"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// fakeAPI serves the recorded responses of testdata by endpoint path.
+// Paths listed in failing answer 500.
+func fakeAPI(t *testing.T, failing ...string) *httptest.Server {
+	t.Helper()
+	files := map[string]string{
+		v2.TokenDetails:  "token_details.json",
+		v2.TokenSecurity: "token_security.json",
+		v2.MarketDetails: "market_details.json",
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, p := range failing {
+			if r.URL.Path == p {
+				http.Error(w, `{"error":"internal error"}`, http.StatusInternalServerError)
+				return
+			}
+		}
+		name, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+var testConfig = &Config{
+	Tokens:   []Target{{Address: "0x6982508145454ce325ddbe47a25d4ec3d2311933", Blockchain: "ethereum"}},
+	Pools:    []Target{{Address: "0xa43fe16908251ee70ef74718545e4fe6c5ccec9f", Blockchain: "ethereum"}},
+	Security: true,
+}
+
+func newTestExporter(t *testing.T, failing ...string) *Exporter {
+	srv := fakeAPI(t, failing...)
+	client := mobula.NewClient(&mobula.Config{BaseURL: srv.URL})
+	clk := clock.NewFake(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	return New(client, testConfig, &Options{Clock: clk})
+}
+
+func scrape(t *testing.T, e *Exporter) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("content type %q", ct)
+	}
+	return rec.Body.String()
+}
+
+func TestHandler(t *testing.T) {
+	e := newTestExporter(t)
+	if err := e.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	got := scrape(t, e)
+
+	golden := filepath.Join("testdata", "metrics.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("scrape differs from %s; run go test ./metrics -update and review the diff\n%s", golden, got)
+	}
+}
+
+func TestHandlerBeforeRefresh(t *testing.T) {
+	e := newTestExporter(t)
+	if got := scrape(t, e); got != "" {
+		t.Errorf("scrape before any refresh = %q, want nothing", got)
+	}
+}
+
+func TestSecurityFailureDropsTarget(t *testing.T) {
+	e := newTestExporter(t, v2.TokenSecurity)
+	err := e.Refresh(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "security") {
+		t.Fatalf("Refresh error = %v, want a security failure", err)
+	}
+	got := scrape(t, e)
+
+	token := `address="0x6982508145454ce325ddbe47a25d4ec3d2311933"`
+	pool := `address="0xa43fe16908251ee70ef74718545e4fe6c5ccec9f"`
+	for _, line := range strings.Split(got, "\n") {
+		if strings.HasPrefix(line, "mobula_token_") && strings.Contains(line, token) {
+			t.Errorf("failed token still reports %q", line)
+		}
+	}
+	for _, want := range []string{
+		`mobula_up{kind="token",blockchain="ethereum",` + token + `} 0`,
+		`mobula_fetch_errors_total{kind="token",blockchain="ethereum",` + token + `} 1`,
+		`mobula_up{kind="pool",blockchain="ethereum",` + pool + `} 1`,
+		`mobula_pool_liquidity_usd{blockchain="ethereum",` + pool + `,pair="PEPE/WETH",exchange="Uniswap V2"} 1.25e+07`,
+	} {
+		if !strings.Contains(got, want+"\n") {
+			t.Errorf("scrape lacks %q", want)
+		}
+	}
+	if strings.Contains(got, "mobula_last_success_timestamp_seconds{kind=\"token\"") {
+		t.Error("a token that never succeeded reports a last success")
+	}
+}
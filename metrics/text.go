@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Label is a Prometheus label pair.
+type Label struct {
+	Name, Value string
+}
+
+// Sample is one gauge value.
+type Sample struct {
+	Metric string
+	Labels []Label
+	Value  float64
+}
+
+// desc documents a metric family.
+type desc struct {
+	name, help string
+	counter    bool
+}
+
+// writeText writes samples in the Prometheus text exposition format 0.0.4,
+// grouped by the families of descs in order and sorted by labels within a
+// family so the output is stable between scrapes.
+func writeText(w io.Writer, descs []desc, samples []Sample) error {
+	byMetric := map[string][]Sample{}
+	for _, s := range samples {
+		byMetric[s.Metric] = append(byMetric[s.Metric], s)
+	}
+	bw := bufio.NewWriter(w)
+	for _, d := range descs {
+		family := byMetric[d.name]
+		if len(family) == 0 {
+			continue
+		}
+		typ := "gauge"
+		if d.counter {
+			typ = "counter"
+		}
+		bw.WriteString("# HELP " + d.name + " " + escapeHelp(d.help) + "\n")
+		bw.WriteString("# TYPE " + d.name + " " + typ + "\n")
+		lines := make([]string, len(family))
+		for i, s := range family {
+			lines[i] = line(s)
+		}
+		sort.Strings(lines)
+		for _, l := range lines {
+			bw.WriteString(l)
+		}
+	}
+	return bw.Flush()
+}
+
+func line(s Sample) string {
+	var b strings.Builder
+	b.WriteString(s.Metric)
+	if len(s.Labels) > 0 {
+		b.WriteByte('{')
+		for i, l := range s.Labels {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(l.Name + `="` + escapeLabel(l.Value) + `"`)
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(formatValue(s.Value))
+	b.WriteByte('\n')
+	return b.String()
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabel(s string) string { return labelEscaper.Replace(s) }
+func escapeHelp(s string) string  { return helpEscaper.Replace(s) }
@@ -0,0 +1,149 @@
+package pools
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// PriceOptions tune Price.
+type PriceOptions struct {
+	MaxAge          time.Duration // pools without a trade for longer are excluded, default 24h; negative disables
+	MinLiquidityUSD float64       // pools with less liquidity are excluded, default 1000; negative disables
+	TrimFraction    float64       // share cut from each end for TrimmedMean, default 0.1
+}
+
+// Exclusion is a pool left out of an aggregate price.
+type Exclusion struct {
+	Pool   string
+	Reason string
+}
+
+// AggregatePrice combines the prices a token trades at across its pools.
+type AggregatePrice struct {
+	LiquidityWeighted float64 // weighted by pool liquidity
+	VolumeWeighted    float64 // weighted by 24h volume, zero without volume
+	Median            float64
+	TrimmedMean       float64
+	Pools             int     // pools used
+	LiquidityUSD      float64 // liquidity of the pools used
+	Dispersion        float64 // liquidity-weighted standard deviation relative to LiquidityWeighted
+	// Confidence, from 0 to 1, grows with the number of pools, their
+	// liquidity and their agreement: it is the product of 1 - 0.5^Pools,
+	// log10(1 + LiquidityUSD) / 6 capped at 1, and exp(-Dispersion / 0.02).
+	Confidence float64
+	Excluded   []Exclusion
+}
+
+// Price aggregates the prices of token over markets, excluding stale and
+// thin pools. token is the address the markets were fetched for; in markets
+// where it is the quote token its price is derived from the pool as PriceUSD
+// / PriceToken.
+// With no usable pool every price is zero.
+func Price(token string, markets []v2.Market, opts *PriceOptions) *AggregatePrice {
+	var o PriceOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.MaxAge == 0 {
+		o.MaxAge = 24 * time.Hour
+	}
+	if o.MinLiquidityUSD == 0 {
+		o.MinLiquidityUSD = 1000
+	}
+	if o.TrimFraction <= 0 || o.TrimFraction >= 0.5 {
+		o.TrimFraction = 0.1
+	}
+
+	type sample struct {
+		price, liquidity, volume float64
+	}
+	agg := &AggregatePrice{Excluded: []Exclusion{}}
+	var samples []sample
+	now := time.Now()
+	for i := range markets {
+		m := &markets[i]
+		price := m.PriceUSD
+		if token != "" && address.Equal(m.Quote.Address, token) {
+			// The pool prices its base token in USD and in quote tokens;
+			// their ratio is what the pool itself prices the quote at,
+			// where Quote.PriceUSD is the token's price elsewhere.
+			price = 0
+			if m.PriceToken > 0 {
+				price = m.PriceUSD / m.PriceToken
+			}
+		}
+		switch {
+		case price <= 0:
+			agg.Excluded = append(agg.Excluded, Exclusion{m.Address, "no price"})
+		case o.MinLiquidityUSD > 0 && m.LiquidityUSD < o.MinLiquidityUSD:
+			agg.Excluded = append(agg.Excluded, Exclusion{m.Address, "low liquidity"})
+		case o.MaxAge > 0 && now.Sub(m.LatestTradeDate) > o.MaxAge:
+			agg.Excluded = append(agg.Excluded, Exclusion{m.Address, "stale"})
+		default:
+			samples = append(samples, sample{price, m.LiquidityUSD, m.Volume24HUSD})
+		}
+	}
+	if len(samples) == 0 {
+		return agg
+	}
+
+	var byLiquidity, liquidity, byVolume, volume float64
+	prices := make([]float64, len(samples))
+	for i, s := range samples {
+		byLiquidity += s.price * s.liquidity
+		liquidity += s.liquidity
+		byVolume += s.price * s.volume
+		volume += s.volume
+		prices[i] = s.price
+	}
+	agg.Pools = len(samples)
+	agg.LiquidityUSD = liquidity
+	if liquidity > 0 {
+		agg.LiquidityWeighted = byLiquidity / liquidity
+	}
+	if volume > 0 {
+		agg.VolumeWeighted = byVolume / volume
+	}
+
+	sort.Float64s(prices)
+	n := len(prices)
+	if n%2 == 1 {
+		agg.Median = prices[n/2]
+	} else {
+		agg.Median = (prices[n/2-1] + prices[n/2]) / 2
+	}
+	trim := int(float64(n) * o.TrimFraction)
+	var sum float64
+	for _, p := range prices[trim : n-trim] {
+		sum += p
+	}
+	agg.TrimmedMean = sum / float64(n-2*trim)
+
+	if agg.LiquidityWeighted > 0 {
+		var variance float64
+		for _, s := range samples {
+			d := s.price/agg.LiquidityWeighted - 1
+			variance += s.liquidity * d * d
+		}
+		agg.Dispersion = math.Sqrt(variance / liquidity)
+	}
+	count := 1 - math.Pow(0.5, float64(agg.Pools))
+	depth := math.Min(1, math.Log10(1+liquidity)/6)
+	agreement := math.Exp(-agg.Dispersion / 0.02)
+	agg.Confidence = count * depth * agreement
+	return agg
+}
+
+// FetchPrice fetches the markets of a token and aggregates their prices.
+func FetchPrice(ctx context.Context, client v2.HTTPClient, req *v2.TokenMarketsRequest, opts *PriceOptions) (*AggregatePrice, error) {
+	resp, err := v2.GetTokenMarkets(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	return Price(req.Address, resp.Data, opts), nil
+}
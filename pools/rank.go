@@ -0,0 +1,155 @@
+// Package pools picks and combines the pools a token trades in. Rank orders
+// the markets of a token with a pluggable Scorer so pricing and charting
+// agree on the main pool, and Price aggregates the pool prices into one.
+package pools
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// QuoteClass is the kind of token a pool prices against.
+type QuoteClass string
+
+const (
+	QuoteStable QuoteClass = "stable"
+	QuoteNative QuoteClass = "native"
+	QuoteOther  QuoteClass = "other"
+)
+
+var stableSymbols = map[string]bool{
+	"USDC": true, "USDT": true, "DAI": true, "USDS": true, "USDE": true, "FDUSD": true,
+	"PYUSD": true, "TUSD": true, "BUSD": true, "USD1": true, "USDC.E": true, "USDBC": true,
+}
+
+var nativeSymbols = map[string]bool{
+	"ETH": true, "WETH": true, "SOL": true, "WSOL": true, "BNB": true, "WBNB": true,
+	"AVAX": true, "WAVAX": true, "POL": true, "WPOL": true, "MATIC": true, "WMATIC": true,
+	"S": true, "WS": true, "HYPE": true, "WHYPE": true, "TRX": true, "WTRX": true,
+}
+
+// Classify tells stablecoins and native or wrapped native tokens apart from
+// other tokens by symbol.
+func Classify(t *v2.Token) QuoteClass {
+	symbol := strings.ToUpper(t.Symbol)
+	switch {
+	case stableSymbols[symbol]:
+		return QuoteStable
+	case nativeSymbols[symbol]:
+		return QuoteNative
+	}
+	return QuoteOther
+}
+
+// Candidate is a pool being scored: the market, and the token on its other
+// side.
+type Candidate struct {
+	Market  *v2.Market
+	Counter *v2.Token
+	Quote   QuoteClass
+}
+
+// Scorer rates a pool; higher is better.
+type Scorer func(c Candidate) float64
+
+// Weights tune LiquidityFirst.
+type Weights struct {
+	// RecencyHalfLife is the time since the last trade after which a pool
+	// keeps only three quarters of its score; the score decays towards half
+	// for pools that stopped trading.
+	RecencyHalfLife time.Duration
+	Stable          float64 // multiplier for pools quoted in a stablecoin
+	Native          float64 // multiplier for pools quoted in the native token
+	Other           float64 // multiplier for other pools
+}
+
+// DefaultWeights prefer stable over native over other quotes, and pools that
+// traded within the last hours.
+func DefaultWeights() Weights {
+	return Weights{RecencyHalfLife: 6 * time.Hour, Stable: 1, Native: 0.9, Other: 0.75}
+}
+
+// DefaultScorer is LiquidityFirst with DefaultWeights.
+func DefaultScorer() Scorer {
+	return LiquidityFirst(DefaultWeights())
+}
+
+// LiquidityFirst scores a pool by the order of magnitude of its liquidity,
+// scaled down for stale pools and by quote class. Liquidity dominates: a
+// pool ten times deeper beats any quote or recency preference unless its
+// liquidity is small.
+func LiquidityFirst(w Weights) Scorer {
+	return func(c Candidate) float64 {
+		score := math.Log10(1 + c.Market.LiquidityUSD)
+		if w.RecencyHalfLife > 0 {
+			if c.Market.LatestTradeDate.IsZero() {
+				score *= 0.5
+			} else {
+				age := time.Since(c.Market.LatestTradeDate)
+				score *= 0.5 + 0.5*math.Exp2(-float64(max(age, 0))/float64(w.RecencyHalfLife))
+			}
+		}
+		switch c.Quote {
+		case QuoteStable:
+			score *= w.Stable
+		case QuoteNative:
+			score *= w.Native
+		default:
+			score *= w.Other
+		}
+		return score
+	}
+}
+
+// Ranked is a market with its score.
+type Ranked struct {
+	v2.Market
+	Quote QuoteClass
+	Score float64
+}
+
+// Rank scores the markets of token, best first. token is the address of the
+// token the markets were fetched for and decides which side is the quote;
+// when it is empty the Quote side of each market is used. A nil scorer means
+// DefaultScorer.
+func Rank(token string, markets []v2.Market, scorer Scorer) []Ranked {
+	if scorer == nil {
+		scorer = DefaultScorer()
+	}
+	ranked := make([]Ranked, 0, len(markets))
+	for i := range markets {
+		m := &markets[i]
+		counter := &m.Quote
+		if token != "" && address.Equal(m.Quote.Address, token) {
+			counter = &m.Base
+		}
+		c := Candidate{Market: m, Counter: counter, Quote: Classify(counter)}
+		ranked = append(ranked, Ranked{Market: *m, Quote: c.Quote, Score: scorer(c)})
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].Score > ranked[j].Score })
+	return ranked
+}
+
+// Best returns the highest-ranked market of token, or nil without markets.
+func Best(token string, markets []v2.Market, scorer Scorer) *Ranked {
+	ranked := Rank(token, markets, scorer)
+	if len(ranked) == 0 {
+		return nil
+	}
+	return &ranked[0]
+}
+
+// RankTokenMarkets fetches the markets of a token and ranks them.
+func RankTokenMarkets(ctx context.Context, client v2.HTTPClient, req *v2.TokenMarketsRequest, scorer Scorer) ([]Ranked, error) {
+	resp, err := v2.GetTokenMarkets(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	return Rank(req.Address, resp.Data, scorer), nil
+}
@@ -0,0 +1,316 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/internal/address"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// AggregateOptions tune an Aggregator.
+type AggregateOptions struct {
+	Concurrency int           // wallets fetched at once, default 8
+	MaxRetries  int           // retries of a rate-limited (429) request, default 3
+	StaleAfter  time.Duration // wallets indexed longer ago are reported stale; zero disables
+}
+
+// WalletRef is a wallet to aggregate with optional chain hints; without
+// hints every chain is fetched.
+type WalletRef struct {
+	Address     string
+	Blockchains []string
+}
+
+// Position is a wallet position within an aggregate.
+type Position struct {
+	Wallet string
+	v2.WalletPosition
+}
+
+// AssetTotal is the value of one asset across wallets and chains. Tokens
+// mapped to the same Mobula asset, such as USDC on several chains, share an
+// AssetTotal; tokens without an asset are keyed by chain and address.
+type AssetTotal struct {
+	Key       string // "asset:<id>" or "token:<chain>:<address>"
+	AssetID   int    // zero for unmapped tokens
+	Symbol    string
+	Name      string
+	Balance   float64 // in token units, summed over deployments
+	ValueUSD  float64
+	ByChain   map[string]float64
+	ByWallet  map[string]float64
+	Positions []Position
+}
+
+// WalletFailure is a wallet whose holdings could not be fetched; it is left
+// out of the totals.
+type WalletFailure struct {
+	Wallet string
+	Err    error
+}
+
+// StaleWallet is a wallet whose balances were indexed longer ago than
+// StaleAfter; it is still counted in the totals.
+type StaleWallet struct {
+	Wallet    string
+	UpdatedAt time.Time
+}
+
+// Aggregate is the merged holdings of a set of wallets.
+type Aggregate struct {
+	TotalUSD float64
+	ByAsset  []AssetTotal // by value, largest first
+	ByChain  map[string]float64
+	ByWallet map[string]float64
+	Failed   []WalletFailure
+	Stale    []StaleWallet
+}
+
+// Aggregator merges the holdings of many wallets. It remembers which asset
+// each token maps to, so repeated aggregations only resolve new tokens. It is
+// safe for concurrent use.
+type Aggregator struct {
+	client v2.HTTPClient
+	opts   AggregateOptions
+
+	mu     sync.Mutex
+	assets map[string]*v2.Asset // by token key; nil for unmapped tokens
+}
+
+// NewAggregator creates an Aggregator reading through client.
+func NewAggregator(client v2.HTTPClient, opts *AggregateOptions) *Aggregator {
+	var o AggregateOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = 8
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = 3
+	}
+	return &Aggregator{client: client, opts: o, assets: map[string]*v2.Asset{}}
+}
+
+// Aggregate fetches every wallet concurrently and merges the positions by
+// asset. Duplicate wallets are fetched once, with their chain hints merged.
+// Only a cancelled context fails the whole call; wallets that fail on their
+// own are listed in Aggregate.Failed.
+func (a *Aggregator) Aggregate(ctx context.Context, wallets []WalletRef) (*Aggregate, error) {
+	refs := dedupe(wallets)
+	portfolios := make([]*v2.WalletPortfolioData, len(refs))
+	errs := make([]error, len(refs))
+
+	a.parallel(len(refs), func(i int) {
+		portfolios[i], errs[i] = a.fetch(ctx, refs[i])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var tokens []v2.WalletPosition
+	seen := map[string]bool{}
+	for _, p := range portfolios {
+		if p == nil {
+			continue
+		}
+		for _, pos := range p.Positions {
+			if key := tokenKey(pos.Blockchain, pos.Address); !seen[key] {
+				seen[key] = true
+				tokens = append(tokens, pos)
+			}
+		}
+	}
+	a.parallel(len(tokens), func(i int) {
+		a.resolve(ctx, tokens[i].Address, tokens[i].Blockchain)
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	agg := &Aggregate{ByAsset: []AssetTotal{}, ByChain: map[string]float64{}, ByWallet: map[string]float64{}}
+	totals := map[string]*AssetTotal{}
+	now := time.Now()
+	for i, ref := range refs {
+		if errs[i] != nil {
+			agg.Failed = append(agg.Failed, WalletFailure{Wallet: ref.Address, Err: errs[i]})
+			continue
+		}
+		p := portfolios[i]
+		if a.opts.StaleAfter > 0 && now.Sub(p.UpdatedAt) > a.opts.StaleAfter {
+			agg.Stale = append(agg.Stale, StaleWallet{Wallet: ref.Address, UpdatedAt: p.UpdatedAt})
+		}
+		agg.ByWallet[ref.Address] = 0
+		for _, pos := range p.Positions {
+			asset := a.asset(pos.Address, pos.Blockchain)
+			t := totals[assetKey(asset, pos)]
+			if t == nil {
+				t = &AssetTotal{Key: assetKey(asset, pos), Symbol: pos.Symbol, Name: pos.Name, ByChain: map[string]float64{}, ByWallet: map[string]float64{}}
+				if asset != nil {
+					t.AssetID, t.Symbol, t.Name = asset.ID, asset.Symbol, asset.Name
+				}
+				totals[t.Key] = t
+			}
+			t.Balance += pos.Balance
+			t.ValueUSD += pos.ValueUSD
+			t.ByChain[pos.Blockchain] += pos.ValueUSD
+			t.ByWallet[ref.Address] += pos.ValueUSD
+			t.Positions = append(t.Positions, Position{Wallet: ref.Address, WalletPosition: pos})
+
+			agg.TotalUSD += pos.ValueUSD
+			agg.ByChain[pos.Blockchain] += pos.ValueUSD
+			agg.ByWallet[ref.Address] += pos.ValueUSD
+		}
+	}
+
+	for _, t := range totals {
+		agg.ByAsset = append(agg.ByAsset, *t)
+	}
+	sort.Slice(agg.ByAsset, func(i, j int) bool {
+		if agg.ByAsset[i].ValueUSD != agg.ByAsset[j].ValueUSD {
+			return agg.ByAsset[i].ValueUSD > agg.ByAsset[j].ValueUSD
+		}
+		return agg.ByAsset[i].Key < agg.ByAsset[j].Key
+	})
+	return agg, nil
+}
+
+func (a *Aggregator) fetch(ctx context.Context, ref WalletRef) (*v2.WalletPortfolioData, error) {
+	var resp *v2.WalletPortfolioResponse
+	err := a.call(ctx, func() error {
+		var err error
+		resp, err = v2.GetWalletPortfolio(ctx, a.client, &v2.WalletPortfolioRequest{Wallet: ref.Address, Blockchains: ref.Blockchains})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+// parallel runs fn for 0 to n-1 on at most Concurrency goroutines.
+func (a *Aggregator) parallel(n int, fn func(i int)) {
+	sem := make(chan struct{}, a.opts.Concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
+
+// resolve looks up the Mobula asset a token belongs to unless it is known.
+// Tokens the asset endpoint does not know are remembered as unmapped.
+func (a *Aggregator) resolve(ctx context.Context, address, blockchain string) {
+	key := tokenKey(blockchain, address)
+	a.mu.Lock()
+	_, ok := a.assets[key]
+	a.mu.Unlock()
+	if ok {
+		return
+	}
+
+	var resp *v2.AssetDetailsResponse
+	err := a.call(ctx, func() error {
+		var err error
+		resp, err = v2.GetAssetDetails(ctx, a.client, &v2.AssetDetailsRequest{Address: address, Blockchain: blockchain})
+		return err
+	})
+	var apiErr *mobula.APIError
+	if err != nil && !(errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound) {
+		// Transient failure: group the token on its own this time and
+		// retry on the next aggregation.
+		return
+	}
+	var asset *v2.Asset
+	if err == nil && resp.Data.Asset.ID != 0 {
+		asset = &resp.Data.Asset
+	}
+	a.mu.Lock()
+	a.assets[key] = asset
+	a.mu.Unlock()
+}
+
+// asset returns the resolved asset of a token, or nil when it is unmapped.
+func (a *Aggregator) asset(address, blockchain string) *v2.Asset {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.assets[tokenKey(blockchain, address)]
+}
+
+// call runs fn, retrying with exponential backoff while the
+// API answers 429 Too Many Requests.
+func (a *Aggregator) call(ctx context.Context, fn func() error) error {
+	backoff := 500 * time.Millisecond
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= a.opts.MaxRetries || !mobula.IsRateLimited(err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// dedupe merges wallets listed more than once. A wallet listed once without
+// chain hints is fetched on every chain.
+func dedupe(wallets []WalletRef) []WalletRef {
+	var out []WalletRef
+	index := map[string]int{}
+	for _, w := range wallets {
+		key := address.Normalize(w.Address)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(out)
+			out = append(out, WalletRef{Address: w.Address, Blockchains: append([]string(nil), w.Blockchains...)})
+			continue
+		}
+		if len(out[i].Blockchains) == 0 || len(w.Blockchains) == 0 {
+			out[i].Blockchains = nil
+			continue
+		}
+		for _, c := range w.Blockchains {
+			if !containsFold(out[i].Blockchains, c) {
+				out[i].Blockchains = append(out[i].Blockchains, c)
+			}
+		}
+	}
+	return out
+}
+
+func assetKey(asset *v2.Asset, pos v2.WalletPosition) string {
+	if asset != nil {
+		return "asset:" + strconv.Itoa(asset.ID)
+	}
+	return "token:" + tokenKey(pos.Blockchain, pos.Address)
+}
+
+func tokenKey(blockchain, addr string) string {
+	return address.Key(blockchain, addr)
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package portfolio
+
+import (
+	"math"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Stats summarise the performance of a timeline.
+//
+// Period returns are PnL based: the change in realized plus unrealized PnL
+// over a period divided by the balance at its start. Deposits and
+// withdrawals change the balance but not the PnL, so they do not show up as
+// gains or losses. Periods starting from a zero balance are skipped.
+type Stats struct {
+	Returns     []float64 // one per period, as fractions
+	TotalReturn float64   // time-weighted: the product of (1 + r) minus 1
+	MaxDrawdown float64   // largest peak-to-trough fall of the return index, as a positive fraction
+	Volatility  float64   // sample standard deviation of Returns
+	PnLUSD      float64   // change in total PnL over the timeline
+}
+
+// Analyze computes Stats for points, which must be sorted by time; resample
+// first to get daily or weekly figures.
+func Analyze(points []v2.WalletHistoryPoint) Stats {
+	s := Stats{Returns: []float64{}}
+	if len(points) < 2 {
+		return s
+	}
+
+	index, peak := 1.0, 1.0
+	for i := 1; i < len(points); i++ {
+		prev, cur := points[i-1], points[i]
+		if prev.BalanceUSD <= 0 {
+			continue
+		}
+		r := (pnl(cur) - pnl(prev)) / prev.BalanceUSD
+		s.Returns = append(s.Returns, r)
+
+		index *= 1 + r
+		peak = math.Max(peak, index)
+		s.MaxDrawdown = math.Max(s.MaxDrawdown, 1-index/peak)
+	}
+	s.TotalReturn = index - 1
+	s.Volatility = stddev(s.Returns)
+	s.PnLUSD = pnl(points[len(points)-1]) - pnl(points[0])
+	return s
+}
+
+// Annualize scales a per-period volatility to a yearly one, given the number
+// of periods in a year (365 for daily returns, 52 for weekly).
+func Annualize(volatility float64, periodsPerYear float64) float64 {
+	return volatility * math.Sqrt(periodsPerYear)
+}
+
+func pnl(p v2.WalletHistoryPoint) float64 {
+	return p.RealizedPnlUSD + p.UnrealizedPnlUSD
+}
+
+func stddev(xs []float64) float64 {
+	if len(xs) < 2 {
+		return 0
+	}
+	var mean float64
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+	var sum float64
+	for _, x := range xs {
+		sum += (x - mean) * (x - mean)
+	}
+	return math.Sqrt(sum / float64(len(xs)-1))
+}
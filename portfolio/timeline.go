@@ -0,0 +1,153 @@
+// Package portfolio builds and analyses wallet net-worth timelines: fetching
+// them per chain or aggregated, resampling them to days or weeks, and
+// deriving returns, drawdown and volatility.
+package portfolio
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Granularity is the bucket size of a resampled timeline.
+type Granularity string
+
+const (
+	Raw  Granularity = ""     // points as returned by the API
+	Day  Granularity = "day"  // one point per UTC day
+	Week Granularity = "week" // one point per ISO week, starting Monday UTC
+)
+
+// TimelineOptions tune FetchTimeline.
+type TimelineOptions struct {
+	Blockchains []string // default all chains
+	// PerChain fetches each of Blockchains separately and merges the
+	// series locally, keeping the per-chain series in Timeline.Chains.
+	PerChain    bool
+	From, To    time.Time // zero values leave the range to the API
+	Granularity Granularity
+}
+
+// Timeline is the net-worth history of a wallet, oldest point first.
+type Timeline struct {
+	Wallet string
+	Points []v2.WalletHistoryPoint
+	Chains map[string][]v2.WalletHistoryPoint // set with PerChain
+}
+
+// FetchTimeline retrieves the history of wallet.
+func FetchTimeline(ctx context.Context, client v2.HTTPClient, wallet string, opts *TimelineOptions) (*Timeline, error) {
+	var o TimelineOptions
+	if opts != nil {
+		o = *opts
+	}
+	req := &v2.WalletHistoryRequest{Wallet: wallet, Blockchains: o.Blockchains}
+	if !o.From.IsZero() {
+		req.From = o.From.UnixMilli()
+	}
+	if !o.To.IsZero() {
+		req.To = o.To.UnixMilli()
+	}
+
+	tl := &Timeline{Wallet: wallet}
+	if !o.PerChain || len(o.Blockchains) == 0 {
+		resp, err := v2.GetWalletHistory(ctx, client, req)
+		if err != nil {
+			return nil, err
+		}
+		tl.Points = Resample(resp.Data.BalanceHistory, o.Granularity)
+		return tl, nil
+	}
+
+	tl.Chains = map[string][]v2.WalletHistoryPoint{}
+	series := make([][]v2.WalletHistoryPoint, 0, len(o.Blockchains))
+	for _, chain := range o.Blockchains {
+		r := *req
+		r.Blockchains = []string{chain}
+		resp, err := v2.GetWalletHistory(ctx, client, &r)
+		if err != nil {
+			return nil, err
+		}
+		points := Resample(resp.Data.BalanceHistory, o.Granularity)
+		tl.Chains[chain] = points
+		series = append(series, points)
+	}
+	tl.Points = Merge(series...)
+	return tl, nil
+}
+
+// Merge sums several series, such as the per-chain histories of a wallet or
+// the histories of several wallets, into one. The result has a point at
+// every time present in any series; a series contributes its latest point at
+// or before that time, and nothing before its first point.
+func Merge(series ...[]v2.WalletHistoryPoint) []v2.WalletHistoryPoint {
+	var times []int64
+	seen := map[int64]bool{}
+	sorted := make([][]v2.WalletHistoryPoint, len(series))
+	for i, s := range series {
+		sorted[i] = sortedCopy(s)
+		for _, p := range s {
+			if !seen[p.Time] {
+				seen[p.Time] = true
+				times = append(times, p.Time)
+			}
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	merged := make([]v2.WalletHistoryPoint, 0, len(times))
+	next := make([]int, len(sorted))
+	for _, t := range times {
+		sum := v2.WalletHistoryPoint{Time: t}
+		for i, s := range sorted {
+			for next[i] < len(s) && s[next[i]].Time <= t {
+				next[i]++
+			}
+			if next[i] == 0 {
+				continue
+			}
+			p := s[next[i]-1]
+			sum.BalanceUSD += p.BalanceUSD
+			sum.RealizedPnlUSD += p.RealizedPnlUSD
+			sum.UnrealizedPnlUSD += p.UnrealizedPnlUSD
+		}
+		merged = append(merged, sum)
+	}
+	return merged
+}
+
+// Resample keeps the last point of every bucket of g, dated at the start of
+// the bucket. Raw returns the points sorted by time.
+func Resample(points []v2.WalletHistoryPoint, g Granularity) []v2.WalletHistoryPoint {
+	sorted := sortedCopy(points)
+	if g == Raw {
+		return sorted
+	}
+	out := make([]v2.WalletHistoryPoint, 0, len(sorted))
+	for _, p := range sorted {
+		p.Time = bucket(time.UnixMilli(p.Time), g).UnixMilli()
+		if n := len(out); n > 0 && out[n-1].Time == p.Time {
+			out[n-1] = p
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func bucket(t time.Time, g Granularity) time.Time {
+	t = t.UTC().Truncate(24 * time.Hour)
+	if g == Week {
+		offset := (int(t.Weekday()) + 6) % 7 // days since Monday
+		t = t.AddDate(0, 0, -offset)
+	}
+	return t
+}
+
+func sortedCopy(points []v2.WalletHistoryPoint) []v2.WalletHistoryPoint {
+	out := append([]v2.WalletHistoryPoint(nil), points...)
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time < out[j].Time })
+	return out
+}
@@ -0,0 +1,56 @@
+package mobula
+
+import (
+	"context"
+
+	"github.com/zomvs/mobula-go-sdk/pools"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// GetGuardedSwapQuote retrieves a swap quote and checks both of its tokens
+// with v2.CheckQuote. The fees and the main pool of each token are only
+// fetched when limits need them; the main pool is the best one of
+// pools.Rank, the same the rest of the SDK picks. A token without any pool
+// counts as holding no liquidity. A quote breaking the limits is not
+// returned.
+func GetGuardedSwapQuote(ctx context.Context, client v2.HTTPClient, req *v2.SwapQuoteRequest, limits v2.QuoteLimits) (*v2.SwapQuoteResponse, error) {
+	quote, err := v2.GetSwapQuote(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	in, err := quoteLeg(ctx, client, req.TokenIn, req.ChainID, limits)
+	if err != nil {
+		return nil, err
+	}
+	out, err := quoteLeg(ctx, client, req.TokenOut, req.ChainID, limits)
+	if err != nil {
+		return nil, err
+	}
+	if err := v2.CheckQuote(&quote.Data, in, out, limits); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// quoteLeg fetches what limits need to know of one token of a quote.
+func quoteLeg(ctx context.Context, client v2.HTTPClient, address, blockchain string, limits v2.QuoteLimits) (v2.QuoteLeg, error) {
+	var leg v2.QuoteLeg
+	if limits.MaxTokenFeePercentage > 0 {
+		resp, err := v2.GetTokenSecurity(ctx, client, &v2.TokenSecurityRequest{Address: address, Blockchain: blockchain})
+		if err != nil {
+			return leg, err
+		}
+		leg.Security = &resp.Data
+	}
+	if limits.MinLiquidityUSD > 0 {
+		ranked, err := pools.RankTokenMarkets(ctx, client, &v2.TokenMarketsRequest{Address: address, Blockchain: blockchain}, nil)
+		if err != nil {
+			return leg, err
+		}
+		leg.Market = &v2.Market{}
+		if len(ranked) > 0 {
+			leg.Market = &ranked[0].Market
+		}
+	}
+	return leg, nil
+}
@@ -0,0 +1,213 @@
+package risk
+
+import (
+	"fmt"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// AnomalyKind classifies an Anomaly.
+type AnomalyKind string
+
+const (
+	// AnomalyNoSells is a window with enough buys and no sells at all.
+	AnomalyNoSells AnomalyKind = "no_sells"
+	// AnomalyLowSellRatio is a window where sells/buys is below the threshold.
+	AnomalyLowSellRatio AnomalyKind = "low_sell_ratio"
+	// AnomalyLowSellerRatio is a window where sellers/buyers is below the threshold.
+	AnomalyLowSellerRatio AnomalyKind = "low_seller_ratio"
+	// AnomalyLowSellVolume is a window where sell volume/buy volume is below the threshold.
+	AnomalyLowSellVolume AnomalyKind = "low_sell_volume"
+	// AnomalyHighFee is a declared buy or sell fee above the threshold.
+	AnomalyHighFee AnomalyKind = "high_fee"
+	// AnomalyFeeAsymmetry is a sell fee far above the buy fee.
+	AnomalyFeeAsymmetry AnomalyKind = "fee_asymmetry"
+)
+
+// Anomaly is one suspicious pattern. Window is empty for fee anomalies.
+type Anomaly struct {
+	Kind      AnomalyKind `json:"kind"`
+	Window    string      `json:"window,omitempty"`
+	Severity  Severity    `json:"severity"`
+	Observed  float64     `json:"observed"`
+	Threshold float64     `json:"threshold"`
+	Detail    string      `json:"detail"`
+}
+
+// FlowThresholds tune AnalyzeFlow.
+type FlowThresholds struct {
+	MinBuys               int     // windows with fewer buys are not judged
+	MinSellRatio          float64 // sells/buys below this is flagged
+	MinSellerRatio        float64 // sellers/buyers below this is flagged
+	MinSellVolumeRatio    float64 // sell volume/buy volume below this is flagged
+	MaxFeePercentage      float64 // buy or sell fee above this is flagged
+	CriticalFeePercentage float64 // flagged fees at or above this are critical; zero disables
+	MaxFeeAsymmetry       float64 // sell fee minus buy fee, in points, above this is flagged
+	Organic               bool    // use the organic (bot filtered) counts
+	MinLongWindowMinute   int     // windows at least this long escalate no-sell anomalies to critical; zero disables
+}
+
+// DefaultFlowThresholds returns thresholds suited to freshly launched tokens.
+func DefaultFlowThresholds() FlowThresholds {
+	return FlowThresholds{
+		MinBuys:               20,
+		MinSellRatio:          0.05,
+		MinSellerRatio:        0.05,
+		MinSellVolumeRatio:    0.02,
+		MaxFeePercentage:      10,
+		CriticalFeePercentage: 50,
+		MaxFeeAsymmetry:       10,
+		MinLongWindowMinute:   60,
+	}
+}
+
+// flowWindow is the trade flow of a token over one window.
+type flowWindow struct {
+	name       string
+	minutes    int
+	buys       int
+	sells      int
+	buyers     int
+	sellers    int
+	volumeBuy  float64
+	volumeSell float64
+}
+
+func windows(t *v2.Token, organic bool) []flowWindow {
+	if organic {
+		return []flowWindow{
+			{"1min", 1, t.OrganicBuys1Min, t.OrganicSells1Min, t.OrganicBuyers1Min, t.OrganicSellers1Min, t.OrganicVolumeBuy1MinUSD, t.OrganicVolumeSell1MinUSD},
+			{"5min", 5, t.OrganicBuys5Min, t.OrganicSells5Min, t.OrganicBuyers5Min, t.OrganicSellers5Min, t.OrganicVolumeBuy5MinUSD, t.OrganicVolumeSell5MinUSD},
+			{"15min", 15, t.OrganicBuys15Min, t.OrganicSells15Min, t.OrganicBuyers15Min, t.OrganicSellers15Min, t.OrganicVolumeBuy15MinUSD, t.OrganicVolumeSell15MinUSD},
+			{"1h", 60, t.OrganicBuys1H, t.OrganicSells1H, t.OrganicBuyers1H, t.OrganicSellers1H, t.OrganicVolumeBuy1HUSD, t.OrganicVolumeSell1HUSD},
+			{"4h", 240, t.OrganicBuys4H, t.OrganicSells4H, t.OrganicBuyers4H, t.OrganicSellers4H, t.OrganicVolumeBuy4HUSD, t.OrganicVolumeSell4HUSD},
+			{"6h", 360, t.OrganicBuys6H, t.OrganicSells6H, t.OrganicBuyers6H, t.OrganicSellers6H, t.OrganicVolumeBuy6HUSD, t.OrganicVolumeSell6HUSD},
+			{"12h", 720, t.OrganicBuys12H, t.OrganicSells12H, t.OrganicBuyers12H, t.OrganicSellers12H, t.OrganicVolumeBuy12HUSD, t.OrganicVolumeSell12HUSD},
+			{"24h", 1440, t.OrganicBuys24H, t.OrganicSells24H, t.OrganicBuyers24H, t.OrganicSellers24H, t.OrganicVolumeBuy24HUSD, t.OrganicVolumeSell24HUSD},
+		}
+	}
+	return []flowWindow{
+		{"1min", 1, t.Buys1Min, t.Sells1Min, t.Buyers1Min, t.Sellers1Min, t.VolumeBuy1MinUSD, t.VolumeSell1MinUSD},
+		{"5min", 5, t.Buys5Min, t.Sells5Min, t.Buyers5Min, t.Sellers5Min, t.VolumeBuy5MinUSD, t.VolumeSell5MinUSD},
+		{"15min", 15, t.Buys15Min, t.Sells15Min, t.Buyers15Min, t.Sellers15Min, t.VolumeBuy15MinUSD, t.VolumeSell15MinUSD},
+		{"1h", 60, t.Buys1H, t.Sells1H, t.Buyers1H, t.Sellers1H, t.VolumeBuy1HUSD, t.VolumeSell1HUSD},
+		{"4h", 240, t.Buys4H, t.Sells4H, t.Buyers4H, t.Sellers4H, t.VolumeBuy4HUSD, t.VolumeSell4HUSD},
+		{"6h", 360, t.Buys6H, t.Sells6H, t.Buyers6H, t.Sellers6H, t.VolumeBuy6HUSD, t.VolumeSell6HUSD},
+		{"12h", 720, t.Buys12H, t.Sells12H, t.Buyers12H, t.Sellers12H, t.VolumeBuy12HUSD, t.VolumeSell12HUSD},
+		{"24h", 1440, t.Buys24H, t.Sells24H, t.Buyers24H, t.Sellers24H, t.VolumeBuy24HUSD, t.VolumeSell24HUSD},
+	}
+}
+
+// AnalyzeFlow compares the declared fees of a token with its observed trade
+// flow. A honeypot shows up as buys with almost no sells, so every window
+// with enough buys is checked for one-sided flow, and each kind of flow
+// anomaly is reported once, for the shortest of its most severe windows.
+// Either argument may be nil.
+func AnalyzeFlow(security *v2.TokenSecurityData, token *v2.Token, th FlowThresholds) []Anomaly {
+	anomalies := []Anomaly{}
+
+	if security != nil {
+		for _, fee := range []struct {
+			side  string
+			value float64
+		}{{"buy", security.BuyFeePercentage}, {"sell", security.SellFeePercentage}} {
+			if fee.value <= th.MaxFeePercentage {
+				continue
+			}
+			severity := SeverityHigh
+			if th.CriticalFeePercentage > 0 && fee.value >= th.CriticalFeePercentage {
+				severity = SeverityCritical
+			}
+			anomalies = append(anomalies, Anomaly{
+				Kind:      AnomalyHighFee,
+				Severity:  severity,
+				Observed:  fee.value,
+				Threshold: th.MaxFeePercentage,
+				Detail:    fmt.Sprintf("%s fee is %g%%", fee.side, fee.value),
+			})
+		}
+		if gap := security.SellFeePercentage - security.BuyFeePercentage; gap > th.MaxFeeAsymmetry {
+			anomalies = append(anomalies, Anomaly{
+				Kind:      AnomalyFeeAsymmetry,
+				Severity:  SeverityHigh,
+				Observed:  gap,
+				Threshold: th.MaxFeeAsymmetry,
+				Detail:    fmt.Sprintf("sell fee is %g points above the buy fee", gap),
+			})
+		}
+	}
+
+	if token == nil {
+		return anomalies
+	}
+	// The windows are cumulative, so a pattern in a short window shows in
+	// the longer ones too. Each kind is reported once, for its most severe
+	// window and the shortest of those.
+	var flow []Anomaly
+	byKind := map[AnomalyKind]int{}
+	add := func(a Anomaly) {
+		i, ok := byKind[a.Kind]
+		switch {
+		case !ok:
+			byKind[a.Kind] = len(flow)
+			flow = append(flow, a)
+		case severityRank[a.Severity] > severityRank[flow[i].Severity]:
+			flow[i] = a
+		}
+	}
+	for _, w := range windows(token, th.Organic) {
+		if w.buys < th.MinBuys || w.buys == 0 {
+			continue
+		}
+		if w.sells == 0 {
+			severity := SeverityHigh
+			if th.MinLongWindowMinute > 0 && w.minutes >= th.MinLongWindowMinute {
+				severity = SeverityCritical
+			}
+			add(Anomaly{
+				Kind:      AnomalyNoSells,
+				Window:    w.name,
+				Severity:  severity,
+				Observed:  0,
+				Threshold: float64(th.MinBuys),
+				Detail:    fmt.Sprintf("%d buys and no sells in %s", w.buys, w.name),
+			})
+			continue
+		}
+		if ratio := float64(w.sells) / float64(w.buys); ratio < th.MinSellRatio {
+			add(Anomaly{
+				Kind:      AnomalyLowSellRatio,
+				Window:    w.name,
+				Severity:  SeverityHigh,
+				Observed:  ratio,
+				Threshold: th.MinSellRatio,
+				Detail:    fmt.Sprintf("%d sells for %d buys in %s", w.sells, w.buys, w.name),
+			})
+		}
+		if w.buyers > 0 {
+			if ratio := float64(w.sellers) / float64(w.buyers); ratio < th.MinSellerRatio {
+				add(Anomaly{
+					Kind:      AnomalyLowSellerRatio,
+					Window:    w.name,
+					Severity:  SeverityMedium,
+					Observed:  ratio,
+					Threshold: th.MinSellerRatio,
+					Detail:    fmt.Sprintf("%d sellers for %d buyers in %s", w.sellers, w.buyers, w.name),
+				})
+			}
+		}
+		if w.volumeBuy > 0 {
+			if ratio := w.volumeSell / w.volumeBuy; ratio < th.MinSellVolumeRatio {
+				add(Anomaly{
+					Kind:      AnomalyLowSellVolume,
+					Window:    w.name,
+					Severity:  SeverityMedium,
+					Observed:  ratio,
+					Threshold: th.MinSellVolumeRatio,
+					Detail:    fmt.Sprintf("$%.0f sold for $%.0f bought in %s", w.volumeSell, w.volumeBuy, w.name),
+				})
+			}
+		}
+	}
+	return append(anomalies, flow...)
+}
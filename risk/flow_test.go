@@ -0,0 +1,100 @@
+package risk
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// summary lists anomalies as kind[:window]=severity.
+func summary(anomalies []Anomaly) []string {
+	out := []string{}
+	for _, a := range anomalies {
+		s := string(a.Kind)
+		if a.Window != "" {
+			s += ":" + a.Window
+		}
+		out = append(out, fmt.Sprintf("%s=%s", s, a.Severity))
+	}
+	return out
+}
+
+func TestAnalyzeFlowFees(t *testing.T) {
+	custom := DefaultFlowThresholds()
+	custom.CriticalFeePercentage = 30
+	disabled := DefaultFlowThresholds()
+	disabled.CriticalFeePercentage = 0
+
+	tests := []struct {
+		name     string
+		buy, sel float64
+		th       FlowThresholds
+		want     []string
+	}{
+		{"under the maximum", 5, 10, DefaultFlowThresholds(), []string{}},
+		{"high sell fee", 5, 20, DefaultFlowThresholds(), []string{"high_fee=high", "fee_asymmetry=high"}},
+		{"critical at the default cutoff", 0, 50, DefaultFlowThresholds(), []string{"high_fee=critical", "fee_asymmetry=high"}},
+		{"custom cutoff", 30, 30, custom, []string{"high_fee=critical", "high_fee=critical"}},
+		{"below a custom cutoff", 29, 29, custom, []string{"high_fee=high", "high_fee=high"}},
+		{"cutoff disabled", 0, 99, disabled, []string{"high_fee=high", "fee_asymmetry=high"}},
+		{"zero thresholds", 0, 5, FlowThresholds{}, []string{"high_fee=high", "fee_asymmetry=high"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sec := &v2.TokenSecurityData{BuyFeePercentage: tt.buy, SellFeePercentage: tt.sel}
+			if got := summary(AnalyzeFlow(sec, nil, tt.th)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("anomalies %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeFlowNoSells(t *testing.T) {
+	// 30 buys and no sells in the 5 minute and 1 hour windows.
+	tok := &v2.Token{Buys5Min: 30, Buyers5Min: 30, Buys1H: 30, Buyers1H: 30}
+	short := DefaultFlowThresholds()
+	short.MinLongWindowMinute = 5
+	disabled := DefaultFlowThresholds()
+	disabled.MinLongWindowMinute = 0
+
+	tests := []struct {
+		name string
+		th   FlowThresholds
+		want []string
+	}{
+		// Reported once, for the most severe window.
+		{"default", DefaultFlowThresholds(), []string{"no_sells:1h=critical"}},
+		{"short long window", short, []string{"no_sells:5min=critical"}},
+		{"escalation disabled", disabled, []string{"no_sells:5min=high"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := summary(AnalyzeFlow(nil, tok, tt.th)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("anomalies %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeFlowOncePerKind(t *testing.T) {
+	// One-sided flow in every cumulative window from 5 minutes on.
+	tok := &v2.Token{
+		Buys5Min: 40, Sells5Min: 1, Buyers5Min: 40, Sellers5Min: 1, VolumeBuy5MinUSD: 4000, VolumeSell5MinUSD: 10,
+		Buys15Min: 80, Sells15Min: 2, Buyers15Min: 70, Sellers15Min: 2, VolumeBuy15MinUSD: 8000, VolumeSell15MinUSD: 20,
+		Buys1H: 200, Sells1H: 4, Buyers1H: 150, Sellers1H: 3, VolumeBuy1HUSD: 20000, VolumeSell1HUSD: 40,
+		Buys4H: 500, Sells4H: 9, Buyers4H: 300, Sellers4H: 5, VolumeBuy4HUSD: 50000, VolumeSell4HUSD: 90,
+		Buys6H: 500, Sells6H: 9, Buyers6H: 300, Sellers6H: 5, VolumeBuy6HUSD: 50000, VolumeSell6HUSD: 90,
+		Buys12H: 500, Sells12H: 9, Buyers12H: 300, Sellers12H: 5, VolumeBuy12HUSD: 50000, VolumeSell12HUSD: 90,
+		Buys24H: 500, Sells24H: 9, Buyers24H: 300, Sellers24H: 5, VolumeBuy24HUSD: 50000, VolumeSell24HUSD: 90,
+	}
+	anomalies := AnalyzeFlow(nil, tok, DefaultFlowThresholds())
+	want := []string{"low_sell_ratio:5min=high", "low_seller_ratio:5min=medium", "low_sell_volume:5min=medium"}
+	if got := summary(anomalies); !reflect.DeepEqual(got, want) {
+		t.Errorf("anomalies %v, want %v", got, want)
+	}
+	if len(anomalies) > 0 && anomalies[0].Detail != "1 sells for 40 buys in 5min" {
+		t.Errorf("detail %q, want the shortest window", anomalies[0].Detail)
+	}
+}
@@ -0,0 +1,133 @@
+// Package risk scores tokens from the security signals Mobula returns. A
+// declarative RuleSet maps signals such as fees, mint authority or holder
+// concentration to findings with a severity, a weight and a reason; the
+// weights add up to a score, and the score and worst severity give a grade.
+//
+// Evaluation is a pure function of an Input, so rule sets can be tested
+// against JSON fixtures of saved responses.
+package risk
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Finding is one rule that fired.
+type Finding struct {
+	Rule     string   `json:"rule"`
+	Signal   string   `json:"signal"`
+	Severity Severity `json:"severity"`
+	Value    float64  `json:"value"`
+	Weight   float64  `json:"weight"`
+	Reason   string   `json:"reason"`
+}
+
+// Report is the outcome of an evaluation. Findings are ordered by severity,
+// then weight; Skipped lists the rules whose signal was not available. Grade
+// is empty when no rule could be evaluated.
+type Report struct {
+	Score    float64   `json:"score"`
+	Grade    string    `json:"grade"`
+	Findings []Finding `json:"findings"`
+	Skipped  []string  `json:"skipped,omitempty"`
+}
+
+// Worst returns the highest severity among the findings, or SeverityInfo.
+func (r *Report) Worst() Severity {
+	worst := SeverityInfo
+	for _, f := range r.Findings {
+		if severityRank[f.Severity] > severityRank[worst] {
+			worst = f.Severity
+		}
+	}
+	return worst
+}
+
+// Engine evaluates a validated rule set.
+type Engine struct {
+	rules RuleSet
+}
+
+// NewEngine creates an engine for rs, or for DefaultRuleSet when rs is nil.
+func NewEngine(rs *RuleSet) (*Engine, error) {
+	if rs == nil {
+		rs = DefaultRuleSet()
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return &Engine{rules: *rs}, nil
+}
+
+// Evaluate scores in.
+func (e *Engine) Evaluate(in Input) *Report {
+	report := &Report{Findings: []Finding{}}
+	for _, r := range e.rules.Rules {
+		value, ok := signals[r.Signal](in)
+		if !ok {
+			report.Skipped = append(report.Skipped, r.ID)
+			continue
+		}
+		if !compare(r.Op, value, r.Value) {
+			continue
+		}
+		report.Score += r.Weight
+		report.Findings = append(report.Findings, Finding{
+			Rule:     r.ID,
+			Signal:   r.Signal,
+			Severity: r.Severity,
+			Value:    value,
+			Weight:   r.Weight,
+			Reason:   reason(r, value),
+		})
+	}
+
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		a, b := report.Findings[i], report.Findings[j]
+		if severityRank[a.Severity] != severityRank[b.Severity] {
+			return severityRank[a.Severity] > severityRank[b.Severity]
+		}
+		return a.Weight > b.Weight
+	})
+
+	if len(report.Skipped) == len(e.rules.Rules) {
+		// Nothing was evaluated; an empty grade is better than a clean one.
+		return report
+	}
+
+	worst := severityRank[report.Worst()]
+	grades := e.rules.Grades
+	report.Grade = grades[len(grades)-1].Name
+	for _, g := range grades {
+		if report.Score <= g.MaxScore && worst <= severityRank[g.MaxSeverity] {
+			report.Grade = g.Name
+			break
+		}
+	}
+	return report
+}
+
+// Fetch collects the Input for a token from the token security and token
+// details endpoints.
+func Fetch(ctx context.Context, client v2.HTTPClient, address, blockchain string) (Input, error) {
+	security, err := v2.GetTokenSecurity(ctx, client, &v2.TokenSecurityRequest{Address: address, Blockchain: blockchain})
+	if err != nil {
+		return Input{}, err
+	}
+	details, err := v2.GetTokenDetails(ctx, client, &v2.TokenDetailsRequest{Address: address, Blockchain: blockchain})
+	if err != nil {
+		return Input{}, err
+	}
+	return Input{Security: &security.Data, Contract: &details.Data.Security}, nil
+}
+
+func reason(r Rule, value float64) string {
+	return strings.NewReplacer(
+		"{value}", strconv.FormatFloat(value, 'f', -1, 64),
+		"{threshold}", strconv.FormatFloat(r.Value, 'f', -1, 64),
+	).Replace(r.Reason)
+}
@@ -0,0 +1,271 @@
+package risk
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// input builds an Input from recorded token security and market details
+// responses in testdata; an empty name leaves that part nil.
+func input(t *testing.T, security, market string) Input {
+	t.Helper()
+	var in Input
+	if security != "" {
+		var resp v2.TokenSecurityResponse
+		readJSON(t, security, &resp)
+		in.Security = &resp.Data
+	}
+	if market != "" {
+		var resp v2.MarketDetailsResponse
+		readJSON(t, market, &resp)
+		in.Contract = &resp.Data.Security
+	}
+	return in
+}
+
+func readJSON(t *testing.T, name string, v any) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+}
+
+func ruleIDs(findings []Finding) []string {
+	ids := []string{}
+	for _, f := range findings {
+		ids = append(ids, f.Rule)
+	}
+	return ids
+}
+
+func TestEvaluateFixtures(t *testing.T) {
+	tests := []struct {
+		name             string
+		security, market string
+		score            float64
+		grade            string
+		worst            Severity
+		findings         []string
+		skipped          []string
+	}{
+		{
+			name:     "clean",
+			security: "security_clean.json", market: "market_clean.json",
+			grade: "A", worst: SeverityInfo,
+			findings: []string{},
+			skipped:  []string{"freezable"},
+		},
+		{
+			name:     "caution",
+			security: "security_caution.json", market: "market_caution.json",
+			score: 20, grade: "B", worst: SeverityMedium,
+			findings: []string{"modifiable-tax", "top10-high"},
+		},
+		{
+			name:     "risky",
+			security: "security_risky.json", market: "market_clean.json",
+			score: 180, grade: "F", worst: SeverityCritical,
+			findings: []string{
+				"sell-fee-extreme",
+				"sell-fee-high", "top10-extreme", "freezable",
+				"buy-fee-high", "mintable", "top10-high", "contract-holdings",
+				"launchpad",
+			},
+		},
+		{
+			name:     "honeypot",
+			security: "security_clean.json", market: "market_honeypot.json",
+			score: 130, grade: "F", worst: SeverityCritical,
+			findings: []string{"honeypot", "blacklist", "closed-source"},
+			skipped:  []string{"freezable"},
+		},
+		{
+			name:     "security only",
+			security: "security_caution.json",
+			score:    10, grade: "B", worst: SeverityMedium,
+			findings: []string{"top10-high"},
+			skipped: []string{"honeypot", "balance-mutable", "transfer-pausable", "blacklist",
+				"self-destruct", "modifiable-tax", "closed-source"},
+		},
+		{
+			name:     "nothing",
+			findings: []string{},
+			worst:    SeverityInfo,
+			skipped:  ruleIDsOf(DefaultRuleSet()),
+		},
+	}
+
+	engine, err := NewEngine(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := engine.Evaluate(input(t, tt.security, tt.market))
+			if r.Score != tt.score || r.Grade != tt.grade || r.Worst() != tt.worst {
+				t.Errorf("score %v grade %q worst %s, want %v %q %s", r.Score, r.Grade, r.Worst(), tt.score, tt.grade, tt.worst)
+			}
+			if got := ruleIDs(r.Findings); !reflect.DeepEqual(got, tt.findings) {
+				t.Errorf("findings %v, want %v", got, tt.findings)
+			}
+			if !reflect.DeepEqual(r.Skipped, tt.skipped) {
+				t.Errorf("skipped %v, want %v", r.Skipped, tt.skipped)
+			}
+		})
+	}
+}
+
+func ruleIDsOf(rs *RuleSet) []string {
+	var ids []string
+	for _, r := range rs.Rules {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+// TestDefaultRules checks every default rule on both sides of its threshold.
+func TestDefaultRules(t *testing.T) {
+	yes := true
+	sec := func(f func(*v2.TokenSecurityData)) Input {
+		s := &v2.TokenSecurityData{}
+		f(s)
+		return Input{Security: s}
+	}
+	con := func(f func(*v2.Security)) Input {
+		s := &v2.Security{}
+		f(s)
+		return Input{Contract: s}
+	}
+	tests := []struct {
+		rule      string
+		fires     Input
+		quiet     Input
+		severity  Severity
+		weight    float64
+		reasonHas string
+	}{
+		{"honeypot", con(func(s *v2.Security) { s.IsHoneypot = true }), con(func(*v2.Security) {}), SeverityCritical, 100, "honeypot"},
+		{"balance-mutable", con(func(s *v2.Security) { s.BalanceMutable = true }), con(func(*v2.Security) {}), SeverityCritical, 60, "balances"},
+		{"sell-fee-extreme", sec(func(s *v2.TokenSecurityData) { s.SellFeePercentage = 50 }), sec(func(s *v2.TokenSecurityData) { s.SellFeePercentage = 49.9 }), SeverityCritical, 60, "sell fee is 50%"},
+		{"sell-fee-high", sec(func(s *v2.TokenSecurityData) { s.SellFeePercentage = 10.5 }), sec(func(s *v2.TokenSecurityData) { s.SellFeePercentage = 10 }), SeverityHigh, 25, "sell fee is 10.5%, above 10%"},
+		{"buy-fee-high", sec(func(s *v2.TokenSecurityData) { s.BuyFeePercentage = 11 }), sec(func(s *v2.TokenSecurityData) { s.BuyFeePercentage = 10 }), SeverityMedium, 15, "buy fee is 11%, above 10%"},
+		{"transfer-pausable", con(func(s *v2.Security) { s.TransferPausable = true }), con(func(*v2.Security) {}), SeverityHigh, 20, "paused"},
+		{"blacklist", con(func(s *v2.Security) { s.IsBlacklisted = true }), con(func(*v2.Security) {}), SeverityHigh, 20, "blacklist"},
+		{"freezable", sec(func(s *v2.TokenSecurityData) { s.IsFreezable = &yes }), sec(func(s *v2.TokenSecurityData) { s.IsFreezable = new(bool) }), SeverityHigh, 20, "frozen"},
+		{"self-destruct", con(func(s *v2.Security) { s.SelfDestruct = true }), con(func(*v2.Security) {}), SeverityHigh, 20, "self-destruct"},
+		{"mintable", sec(func(s *v2.TokenSecurityData) { s.IsMintable = true }), sec(func(*v2.TokenSecurityData) {}), SeverityMedium, 15, "minted"},
+		{"modifiable-tax", con(func(s *v2.Security) { s.ModifyableTax = true }), con(func(*v2.Security) {}), SeverityMedium, 10, "fees"},
+		{"closed-source", con(func(s *v2.Security) { s.IsNotOpenSource = true }), con(func(*v2.Security) {}), SeverityMedium, 10, "not verified"},
+		{"top10-extreme", sec(func(s *v2.TokenSecurityData) { s.Top10HoldingsPercentage = 81 }), sec(func(s *v2.TokenSecurityData) { s.Top10HoldingsPercentage = 80 }), SeverityHigh, 25, "own 81%"},
+		{"top10-high", sec(func(s *v2.TokenSecurityData) { s.Top10HoldingsPercentage = 51 }), sec(func(s *v2.TokenSecurityData) { s.Top10HoldingsPercentage = 50 }), SeverityMedium, 10, "own 51% of supply, above 50%"},
+		{"contract-holdings", sec(func(s *v2.TokenSecurityData) { s.ContractHoldingsPercentage = 21 }), sec(func(s *v2.TokenSecurityData) { s.ContractHoldingsPercentage = 20 }), SeverityMedium, 10, "holds 21%"},
+		{"launchpad", sec(func(s *v2.TokenSecurityData) { s.IsLaunchpadToken = true }), sec(func(*v2.TokenSecurityData) {}), SeverityInfo, 0, "launchpad"},
+	}
+
+	covered := map[string]bool{}
+	for _, tt := range tests {
+		covered[tt.rule] = true
+	}
+	for _, id := range ruleIDsOf(DefaultRuleSet()) {
+		if !covered[id] {
+			t.Errorf("default rule %q has no test", id)
+		}
+	}
+
+	engine, err := NewEngine(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	find := func(r *Report, id string) *Finding {
+		for i := range r.Findings {
+			if r.Findings[i].Rule == id {
+				return &r.Findings[i]
+			}
+		}
+		return nil
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			f := find(engine.Evaluate(tt.fires), tt.rule)
+			if f == nil {
+				t.Fatal("did not fire")
+			}
+			if f.Severity != tt.severity || f.Weight != tt.weight {
+				t.Errorf("severity %s weight %v, want %s %v", f.Severity, f.Weight, tt.severity, tt.weight)
+			}
+			if !strings.Contains(f.Reason, tt.reasonHas) {
+				t.Errorf("reason %q lacks %q", f.Reason, tt.reasonHas)
+			}
+			if f := find(engine.Evaluate(tt.quiet), tt.rule); f != nil {
+				t.Errorf("fired below its threshold: %+v", f)
+			}
+		})
+	}
+}
+
+func TestGrades(t *testing.T) {
+	tests := []struct {
+		in    Input
+		grade string
+	}{
+		{Input{Contract: &v2.Security{}}, "A"},
+		{Input{Contract: &v2.Security{ModifyableTax: true, IsNotOpenSource: true}}, "B"},                      // 20, medium
+		{Input{Contract: &v2.Security{TransferPausable: true}}, "C"},                                          // 20, high
+		{Input{Contract: &v2.Security{TransferPausable: true, IsBlacklisted: true, SelfDestruct: true}}, "D"}, // 60, high
+		{Input{Contract: &v2.Security{BalanceMutable: true}}, "F"},                                            // 60, critical
+	}
+	engine, err := NewEngine(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		if r := engine.Evaluate(tt.in); r.Grade != tt.grade {
+			t.Errorf("%+v: grade %q (score %v, worst %s), want %q", *tt.in.Contract, r.Grade, r.Score, r.Worst(), tt.grade)
+		}
+	}
+}
+
+func TestParseRuleSet(t *testing.T) {
+	valid := `{"rules": [{"id": "fee", "signal": "sellFeePercentage", "op": ">", "value": 5, "severity": "high", "weight": 30, "reason": "sell fee {value}%"}],
+		"grades": [{"name": "ok", "maxScore": 0, "maxSeverity": "info"}, {"name": "bad", "maxScore": 1e9, "maxSeverity": "critical"}]}`
+	rs, err := ParseRuleSet(strings.NewReader(valid))
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine, err := NewEngine(rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := engine.Evaluate(input(t, "security_caution.json", ""))
+	if r.Grade != "ok" || len(r.Findings) != 0 {
+		t.Errorf("caution: %+v", r)
+	}
+	r = engine.Evaluate(input(t, "security_risky.json", ""))
+	if r.Grade != "bad" || r.Score != 30 || r.Findings[0].Reason != "sell fee 55%" {
+		t.Errorf("risky: %+v", r)
+	}
+
+	for _, bad := range []string{
+		`{"rules": [], "grades": []}`,
+		`{"rules": [{"id": "x", "signal": "nope", "op": ">", "severity": "low"}], "grades": [{"name": "A", "maxSeverity": "info"}]}`,
+		`{"rules": [{"id": "x", "signal": "isMintable", "op": "=~", "severity": "low"}], "grades": [{"name": "A", "maxSeverity": "info"}]}`,
+		`{"rules": [{"id": "x", "signal": "isMintable", "op": "==", "severity": "dire"}], "grades": [{"name": "A", "maxSeverity": "info"}]}`,
+		`{"rules": [{"id": "x", "signal": "isMintable", "op": "==", "severity": "low", "weight": -1}], "grades": [{"name": "A", "maxSeverity": "info"}]}`,
+		`{"rules": [{"signal": "isMintable", "op": "==", "severity": "low"}], "grades": [{"name": "A", "maxSeverity": "info"}]}`,
+		`{"rules": [], "grades": [{"name": "A", "maxSeverity": "info"}], "extra": true}`,
+	} {
+		if _, err := ParseRuleSet(strings.NewReader(bad)); err == nil {
+			t.Errorf("ParseRuleSet(%s) succeeded", bad)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package risk
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Severity ranks findings, from SeverityInfo to SeverityCritical.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+var severityRank = map[Severity]int{
+	SeverityInfo:     0,
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// Rule fires when its signal compares true against Value. Boolean signals
+// read as 1 or 0, so "isMintable == 1" fires for mintable tokens.
+type Rule struct {
+	ID       string   `json:"id"`
+	Signal   string   `json:"signal"`   // one of Signals()
+	Op       string   `json:"op"`       // >, >=, <, <=, == or !=
+	Value    float64  `json:"value"`    // threshold
+	Severity Severity `json:"severity"` // severity of the finding
+	Weight   float64  `json:"weight"`   // points added to the score
+	Reason   string   `json:"reason"`   // {value} and {threshold} are substituted
+}
+
+// Grade is reached when the score is at most MaxScore and no finding is more
+// severe than MaxSeverity. Grades are tried in order; the last one should
+// accept anything.
+type Grade struct {
+	Name        string   `json:"name"`
+	MaxScore    float64  `json:"maxScore"`
+	MaxSeverity Severity `json:"maxSeverity"`
+}
+
+// RuleSet is the declarative configuration of an Engine.
+type RuleSet struct {
+	Rules  []Rule  `json:"rules"`
+	Grades []Grade `json:"grades"`
+}
+
+// ParseRuleSet reads a JSON rule set.
+func ParseRuleSet(r io.Reader) (*RuleSet, error) {
+	var rs RuleSet
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&rs); err != nil {
+		return nil, fmt.Errorf("risk: parsing rule set: %w", err)
+	}
+	if err := rs.Validate(); err != nil {
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// Validate reports the first malformed rule or grade.
+func (rs *RuleSet) Validate() error {
+	if len(rs.Grades) == 0 {
+		return fmt.Errorf("risk: rule set has no grades")
+	}
+	seen := map[string]bool{}
+	for _, r := range rs.Rules {
+		switch {
+		case r.ID == "":
+			return fmt.Errorf("risk: rule on %q has no id", r.Signal)
+		case seen[r.ID]:
+			return fmt.Errorf("risk: duplicate rule %q", r.ID)
+		case signals[r.Signal] == nil:
+			return fmt.Errorf("risk: rule %q: unknown signal %q", r.ID, r.Signal)
+		case !validOp(r.Op):
+			return fmt.Errorf("risk: rule %q: unknown operator %q", r.ID, r.Op)
+		case r.Weight < 0:
+			return fmt.Errorf("risk: rule %q: negative weight", r.ID)
+		}
+		if _, ok := severityRank[r.Severity]; !ok {
+			return fmt.Errorf("risk: rule %q: unknown severity %q", r.ID, r.Severity)
+		}
+		seen[r.ID] = true
+	}
+	for _, g := range rs.Grades {
+		if _, ok := severityRank[g.MaxSeverity]; !ok {
+			return fmt.Errorf("risk: grade %q: unknown severity %q", g.Name, g.MaxSeverity)
+		}
+	}
+	return nil
+}
+
+func validOp(op string) bool {
+	switch op {
+	case ">", ">=", "<", "<=", "==", "!=":
+		return true
+	}
+	return false
+}
+
+func compare(op string, a, b float64) bool {
+	switch op {
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	}
+	return false
+}
+
+// DefaultRuleSet returns the rules the SDK ships with. Callers tune weights by
+// editing the returned value or by loading their own JSON with ParseRuleSet.
+func DefaultRuleSet() *RuleSet {
+	return &RuleSet{
+		Rules: []Rule{
+			{ID: "honeypot", Signal: "isHoneypot", Op: "==", Value: 1, Severity: SeverityCritical, Weight: 100, Reason: "token is flagged as a honeypot"},
+			{ID: "balance-mutable", Signal: "balanceMutable", Op: "==", Value: 1, Severity: SeverityCritical, Weight: 60, Reason: "owner can change holder balances"},
+			{ID: "sell-fee-extreme", Signal: "sellFeePercentage", Op: ">=", Value: 50, Severity: SeverityCritical, Weight: 60, Reason: "sell fee is {value}%"},
+			{ID: "sell-fee-high", Signal: "sellFeePercentage", Op: ">", Value: 10, Severity: SeverityHigh, Weight: 25, Reason: "sell fee is {value}%, above {threshold}%"},
+			{ID: "buy-fee-high", Signal: "buyFeePercentage", Op: ">", Value: 10, Severity: SeverityMedium, Weight: 15, Reason: "buy fee is {value}%, above {threshold}%"},
+			{ID: "transfer-pausable", Signal: "transferPausable", Op: "==", Value: 1, Severity: SeverityHigh, Weight: 20, Reason: "transfers can be paused"},
+			{ID: "blacklist", Signal: "isBlacklisted", Op: "==", Value: 1, Severity: SeverityHigh, Weight: 20, Reason: "contract can blacklist holders"},
+			{ID: "freezable", Signal: "isFreezable", Op: "==", Value: 1, Severity: SeverityHigh, Weight: 20, Reason: "token accounts can be frozen"},
+			{ID: "self-destruct", Signal: "selfDestruct", Op: "==", Value: 1, Severity: SeverityHigh, Weight: 20, Reason: "contract can self-destruct"},
+			{ID: "mintable", Signal: "isMintable", Op: "==", Value: 1, Severity: SeverityMedium, Weight: 15, Reason: "supply can be minted"},
+			{ID: "modifiable-tax", Signal: "modifyableTax", Op: "==", Value: 1, Severity: SeverityMedium, Weight: 10, Reason: "owner can change the fees"},
+			{ID: "closed-source", Signal: "isNotOpenSource", Op: "==", Value: 1, Severity: SeverityMedium, Weight: 10, Reason: "contract source is not verified"},
+			{ID: "top10-extreme", Signal: "top10HoldingsPercentage", Op: ">", Value: 80, Severity: SeverityHigh, Weight: 25, Reason: "top 10 holders own {value}% of supply"},
+			{ID: "top10-high", Signal: "top10HoldingsPercentage", Op: ">", Value: 50, Severity: SeverityMedium, Weight: 10, Reason: "top 10 holders own {value}% of supply, above {threshold}%"},
+			{ID: "contract-holdings", Signal: "contractHoldingsPercentage", Op: ">", Value: 20, Severity: SeverityMedium, Weight: 10, Reason: "contract holds {value}% of supply"},
+			{ID: "launchpad", Signal: "isLaunchpadToken", Op: "==", Value: 1, Severity: SeverityInfo, Weight: 0, Reason: "token was created on a launchpad"},
+		},
+		Grades: []Grade{
+			{Name: "A", MaxScore: 0, MaxSeverity: SeverityInfo},
+			{Name: "B", MaxScore: 20, MaxSeverity: SeverityMedium},
+			{Name: "C", MaxScore: 45, MaxSeverity: SeverityHigh},
+			{Name: "D", MaxScore: 80, MaxSeverity: SeverityHigh},
+			{Name: "F", MaxScore: 1e9, MaxSeverity: SeverityCritical},
+		},
+	}
+}
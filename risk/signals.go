@@ -0,0 +1,81 @@
+package risk
+
+import (
+	"sort"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Input gathers the signals a rule set is evaluated against. Either part may
+// be nil; rules on missing signals are skipped and listed in the report.
+type Input struct {
+	Security *v2.TokenSecurityData `json:"security,omitempty"` // GetTokenSecurity data
+	Contract *v2.Security          `json:"contract,omitempty"` // security block of a token or market
+}
+
+// signal reads one value from an Input. Booleans read as 1 or 0; ok is false
+// when the value is not available.
+type signal func(in Input) (value float64, ok bool)
+
+// signals are the names rules may refer to. They follow the JSON field names
+// of the models they are read from.
+var signals = map[string]signal{
+	"buyFeePercentage":           fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.BuyFeePercentage }),
+	"sellFeePercentage":          fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.SellFeePercentage }),
+	"contractHoldingsPercentage": fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.ContractHoldingsPercentage }),
+	"burnedHoldingsPercentage":   fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.BurnedHoldingsPercentage }),
+	"top10HoldingsPercentage":    fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.Top10HoldingsPercentage }),
+	"top50HoldingsPercentage":    fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.Top50HoldingsPercentage }),
+	"top100HoldingsPercentage":   fromSecurity(func(s *v2.TokenSecurityData) float64 { return s.Top100HoldingsPercentage }),
+	"isMintable":                 fromSecurity(func(s *v2.TokenSecurityData) float64 { return boolean(s.IsMintable) }),
+	"isLaunchpadToken":           fromSecurity(func(s *v2.TokenSecurityData) float64 { return boolean(s.IsLaunchpadToken) }),
+	"isFreezable": func(in Input) (float64, bool) {
+		if in.Security == nil || in.Security.IsFreezable == nil {
+			return 0, false
+		}
+		return boolean(*in.Security.IsFreezable), true
+	},
+
+	"transferPausable": fromContract(func(s *v2.Security) bool { return s.TransferPausable }),
+	"isBlacklisted":    fromContract(func(s *v2.Security) bool { return s.IsBlacklisted }),
+	"balanceMutable":   fromContract(func(s *v2.Security) bool { return s.BalanceMutable }),
+	"isHoneypot":       fromContract(func(s *v2.Security) bool { return s.IsHoneypot }),
+	"modifyableTax":    fromContract(func(s *v2.Security) bool { return s.ModifyableTax }),
+	"selfDestruct":     fromContract(func(s *v2.Security) bool { return s.SelfDestruct }),
+	"isNotOpenSource":  fromContract(func(s *v2.Security) bool { return s.IsNotOpenSource }),
+}
+
+// Signals returns the signal names rules may use, sorted.
+func Signals() []string {
+	names := make([]string, 0, len(signals))
+	for name := range signals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func fromSecurity(f func(*v2.TokenSecurityData) float64) signal {
+	return func(in Input) (float64, bool) {
+		if in.Security == nil {
+			return 0, false
+		}
+		return f(in.Security), true
+	}
+}
+
+func fromContract(f func(*v2.Security) bool) signal {
+	return func(in Input) (float64, bool) {
+		if in.Contract == nil {
+			return 0, false
+		}
+		return boolean(f(in.Contract)), true
+	}
+}
+
+func boolean(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
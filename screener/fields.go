@@ -0,0 +1,64 @@
+package screener
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/zomvs/mobula-go-sdk/internal/fields"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// serverFields are the fields the market query endpoint can filter and sort
+// on. Predicates on other fields run client side.
+var serverFields = map[string]bool{
+	"priceUSD":                 true,
+	"marketCapUSD":             true,
+	"marketCapDilutedUSD":      true,
+	"liquidityUSD":             true,
+	"volume1hUSD":              true,
+	"volume24hUSD":             true,
+	"priceChange1hPercentage":  true,
+	"priceChange24hPercentage": true,
+	"holdersCount":             true,
+	"trades24h":                true,
+}
+
+// tokenFields maps the JSON names of the top-level numeric and boolean
+// fields of v2.Token to their field, so queries use the same names as the API.
+var tokenFields = func() map[string]fields.Field {
+	byName := map[string]fields.Field{}
+	for _, f := range fields.Of(reflect.TypeOf(v2.Token{})) {
+		if !f.Nested() && fields.Numeric(f.Type) {
+			byName[f.Name] = f
+		}
+	}
+	return byName
+}()
+
+// Fields returns the names usable in predicates and sorting, sorted.
+func Fields() []string {
+	names := make([]string, 0, len(tokenFields))
+	for name := range tokenFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ServerSide reports whether the market query endpoint supports field.
+func ServerSide(field string) bool {
+	return serverFields[field]
+}
+
+// Value reads field from t; booleans read as 1 or 0.
+func Value(t *v2.Token, name string) (float64, bool) {
+	f, ok := tokenFields[name]
+	if !ok {
+		return 0, false
+	}
+	v, ok := fields.Value(reflect.ValueOf(t), f)
+	if !ok {
+		return 0, false
+	}
+	return fields.Number(v)
+}
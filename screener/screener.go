@@ -0,0 +1,317 @@
+// Package screener builds filtered, sorted token lists. Predicates the market
+// query endpoint understands are sent to the server; the rest run client side
+// over the returned v2.Token models, and the Plan of a query says which ran
+// where.
+//
+//	q := screener.New().
+//		Chain("solana").
+//		Where("liquidityUSD", screener.Gt, 50_000).
+//		Where("volume1hUSD", screener.Gt, 100_000).
+//		Where("top10HoldingsPercentage", screener.Lt, 30).
+//		SortBy("volume1hUSD", true).
+//		Limit(50)
+//	res, err := q.Run(ctx, client)
+package screener
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Op is a comparison operator.
+type Op string
+
+const (
+	Gt  Op = "gt"
+	Gte Op = "gte"
+	Lt  Op = "lt"
+	Lte Op = "lte"
+	Eq  Op = "eq"
+	Ne  Op = "ne"
+)
+
+// serverOps are the operators the market query endpoint accepts.
+var serverOps = map[Op]bool{Gt: true, Gte: true, Lt: true, Lte: true, Eq: true}
+
+// Predicate compares a token field, named as in the API, with a value.
+type Predicate struct {
+	Field string
+	Op    Op
+	Value float64
+}
+
+func (p Predicate) String() string {
+	return fmt.Sprintf("%s %s %s", p.Field, p.Op, strconv.FormatFloat(p.Value, 'f', -1, 64))
+}
+
+// Match reports whether t satisfies p.
+func (p Predicate) Match(t *v2.Token) bool {
+	v, ok := Value(t, p.Field)
+	if !ok {
+		return false
+	}
+	switch p.Op {
+	case Gt:
+		return v > p.Value
+	case Gte:
+		return v >= p.Value
+	case Lt:
+		return v < p.Value
+	case Lte:
+		return v <= p.Value
+	case Eq:
+		return v == p.Value
+	case Ne:
+		return v != p.Value
+	}
+	return false
+}
+
+// DefaultMaxScan bounds how many tokens a query reads when client-side
+// predicates or sorting, or the lack of a Limit, make it page through
+// results.
+const DefaultMaxScan = 1000
+
+// pageSize is the largest page the market query endpoint returns.
+const pageSize = 100
+
+// Query is a screen under construction. Methods return the query so calls
+// can be chained; errors surface from Validate and Run.
+type Query struct {
+	chain      string
+	predicates []Predicate
+	sortField  string
+	sortDesc   bool
+	limit      int
+	maxScan    int
+}
+
+// New starts an empty query.
+func New() *Query {
+	return &Query{maxScan: DefaultMaxScan}
+}
+
+// Chain restricts the query to one blockchain.
+func (q *Query) Chain(chain string) *Query {
+	q.chain = chain
+	return q
+}
+
+// Where adds a predicate.
+func (q *Query) Where(field string, op Op, value float64) *Query {
+	q.predicates = append(q.predicates, Predicate{Field: field, Op: op, Value: value})
+	return q
+}
+
+// SortBy orders the result by field.
+func (q *Query) SortBy(field string, desc bool) *Query {
+	q.sortField = field
+	q.sortDesc = desc
+	return q
+}
+
+// Limit caps the number of tokens returned.
+func (q *Query) Limit(n int) *Query {
+	q.limit = n
+	return q
+}
+
+// MaxScan caps the tokens read from the server when filtering or sorting
+// client side, or when the query has no Limit.
+func (q *Query) MaxScan(n int) *Query {
+	q.maxScan = n
+	return q
+}
+
+// Validate reports unknown fields and operators.
+func (q *Query) Validate() error {
+	for _, p := range q.predicates {
+		if _, ok := tokenFields[p.Field]; !ok {
+			return fmt.Errorf("screener: unknown field %q", p.Field)
+		}
+		switch p.Op {
+		case Gt, Gte, Lt, Lte, Eq, Ne:
+		default:
+			return fmt.Errorf("screener: unknown operator %q", p.Op)
+		}
+	}
+	if q.sortField != "" {
+		if _, ok := tokenFields[q.sortField]; !ok {
+			return fmt.Errorf("screener: unknown sort field %q", q.sortField)
+		}
+	}
+	if q.limit < 0 || q.maxScan < 0 {
+		return fmt.Errorf("screener: negative limit")
+	}
+	return nil
+}
+
+// Plan is where each part of a query runs.
+type Plan struct {
+	Server     []Predicate // sent as market query filters
+	Client     []Predicate // applied to the returned tokens
+	ServerSort bool        // sorting is done by the server
+}
+
+// Plan splits the query between server and client. The server takes one
+// value per field and operator, so repeated bounds are merged into the
+// tighter one, and an equality repeated with another value runs client side.
+func (q *Query) Plan() Plan {
+	var p Plan
+	server := map[Predicate]int{} // field and operator -> index in p.Server
+	for _, pred := range q.predicates {
+		if !serverFields[pred.Field] || !serverOps[pred.Op] {
+			p.Client = append(p.Client, pred)
+			continue
+		}
+		key := Predicate{Field: pred.Field, Op: pred.Op}
+		i, seen := server[key]
+		switch {
+		case !seen:
+			server[key] = len(p.Server)
+			p.Server = append(p.Server, pred)
+		case tighter(pred, p.Server[i]):
+			p.Server[i] = pred
+		case pred.Op == Eq && pred.Value != p.Server[i].Value:
+			p.Client = append(p.Client, pred)
+		}
+	}
+	p.ServerSort = q.sortField == "" || serverFields[q.sortField]
+	return p
+}
+
+// tighter reports whether bound a excludes more than b, for two predicates
+// on the same field with the same operator.
+func tighter(a, b Predicate) bool {
+	switch a.Op {
+	case Gt, Gte:
+		return a.Value > b.Value
+	case Lt, Lte:
+		return a.Value < b.Value
+	}
+	return false
+}
+
+// Result is the outcome of Run.
+type Result struct {
+	Tokens  []v2.Token
+	Plan    Plan
+	Scanned int  // tokens read from the server
+	Partial bool // MaxScan was reached before the server ran out of tokens
+}
+
+// Run executes the query. Pages are read until Limit tokens pass the
+// client-side predicates or the server runs out of tokens. When everything
+// runs on the server, a Limit up to one page is read in a single request.
+// MaxScan caps the tokens read when filtering or sorting client side, or
+// when there is no Limit.
+func (q *Query) Run(ctx context.Context, client v2.HTTPClient) (*Result, error) {
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	plan := q.Plan()
+	req, err := q.request(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Tokens: []v2.Token{}, Plan: plan}
+	serverOnly := len(plan.Client) == 0 && plan.ServerSort
+	scanCapped := !serverOnly || q.limit == 0
+	for {
+		req.Limit = pageSize
+		if serverOnly && q.limit > 0 {
+			req.Limit = min(req.Limit, q.limit-len(res.Tokens))
+		}
+		if scanCapped && q.maxScan > 0 {
+			req.Limit = min(req.Limit, q.maxScan-res.Scanned)
+		}
+		req.Offset = res.Scanned
+
+		resp, err := v2.GetMarketQuery(ctx, client, req)
+		if err != nil {
+			return nil, err
+		}
+		res.Scanned += len(resp.Data)
+		res.Tokens = append(res.Tokens, Filter(resp.Data, plan.Client)...)
+
+		exhausted := len(resp.Data) < req.Limit
+		full := q.limit > 0 && len(res.Tokens) >= q.limit && plan.ServerSort
+		if exhausted || full {
+			break
+		}
+		if scanCapped && q.maxScan > 0 && res.Scanned >= q.maxScan {
+			res.Partial = true
+			break
+		}
+	}
+
+	if !plan.ServerSort {
+		Sort(res.Tokens, q.sortField, q.sortDesc)
+	}
+	if q.limit > 0 && len(res.Tokens) > q.limit {
+		res.Tokens = res.Tokens[:q.limit]
+	}
+	return res, nil
+}
+
+func (q *Query) request(plan Plan) (*v2.MarketQueryRequest, error) {
+	req := &v2.MarketQueryRequest{Blockchain: q.chain}
+	if len(plan.Server) > 0 {
+		filters := map[string]map[Op]float64{}
+		for _, p := range plan.Server {
+			if filters[p.Field] == nil {
+				filters[p.Field] = map[Op]float64{}
+			}
+			filters[p.Field][p.Op] = p.Value
+		}
+		raw, err := json.Marshal(filters)
+		if err != nil {
+			return nil, err
+		}
+		req.Filters = string(raw)
+	}
+	if plan.ServerSort && q.sortField != "" {
+		req.SortBy = q.sortField
+		req.SortOrder = v2.SortOrderAsc
+		if q.sortDesc {
+			req.SortOrder = v2.SortOrderDesc
+		}
+	}
+	return req, nil
+}
+
+// Filter returns the tokens matching every predicate.
+func Filter(tokens []v2.Token, predicates []Predicate) []v2.Token {
+	out := make([]v2.Token, 0, len(tokens))
+	for i := range tokens {
+		ok := true
+		for _, p := range predicates {
+			if !p.Match(&tokens[i]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			out = append(out, tokens[i])
+		}
+	}
+	return out
+}
+
+// Sort orders tokens by field in place.
+func Sort(tokens []v2.Token, field string, desc bool) {
+	sort.SliceStable(tokens, func(i, j int) bool {
+		a, _ := Value(&tokens[i], field)
+		b, _ := Value(&tokens[j], field)
+		if desc {
+			return a > b
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,175 @@
+package screener
+
+import (
+	"context"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+func TestPlanMergesRepeatedBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *Query
+		server  []Predicate
+		client  []Predicate
+		filters string
+	}{
+		{
+			name:    "lower bounds keep the highest",
+			query:   New().Where("liquidityUSD", Gt, 10_000).Where("liquidityUSD", Gt, 50_000).Where("liquidityUSD", Gt, 20_000),
+			server:  []Predicate{{"liquidityUSD", Gt, 50_000}},
+			filters: `{"liquidityUSD":{"gt":50000}}`,
+		},
+		{
+			name:    "upper bounds keep the lowest",
+			query:   New().Where("priceUSD", Lte, 2).Where("priceUSD", Lte, 1).Where("priceUSD", Gte, 0.5),
+			server:  []Predicate{{"priceUSD", Lte, 1}, {"priceUSD", Gte, 0.5}},
+			filters: `{"priceUSD":{"gte":0.5,"lte":1}}`,
+		},
+		{
+			name:    "conflicting equalities",
+			query:   New().Where("holdersCount", Eq, 10).Where("holdersCount", Eq, 10).Where("holdersCount", Eq, 20),
+			server:  []Predicate{{"holdersCount", Eq, 10}},
+			client:  []Predicate{{"holdersCount", Eq, 20}},
+			filters: `{"holdersCount":{"eq":10}}`,
+		},
+		{
+			name:    "client-side fields are kept as written",
+			query:   New().Where("top10HoldingsPercentage", Lt, 30).Where("top10HoldingsPercentage", Lt, 50).Where("liquidityUSD", Ne, 0),
+			client:  []Predicate{{"top10HoldingsPercentage", Lt, 30}, {"top10HoldingsPercentage", Lt, 50}, {"liquidityUSD", Ne, 0}},
+			filters: ``,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tt.query.Plan()
+			if !reflect.DeepEqual(plan.Server, tt.server) {
+				t.Errorf("server %v, want %v", plan.Server, tt.server)
+			}
+			if !reflect.DeepEqual(plan.Client, tt.client) {
+				t.Errorf("client %v, want %v", plan.Client, tt.client)
+			}
+			req, err := tt.query.request(plan)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if req.Filters != tt.filters {
+				t.Errorf("filters %s, want %s", req.Filters, tt.filters)
+			}
+		})
+	}
+}
+
+// stubClient answers market queries with a fixed page and records the
+// filters it was sent.
+type stubClient struct {
+	tokens  []v2.Token
+	filters []string
+}
+
+func (c *stubClient) Get(_ context.Context, _ string, params url.Values, result interface{}) error {
+	c.filters = append(c.filters, params.Get("filters"))
+	result.(*v2.MarketQueryResponse).Data = c.tokens
+	return nil
+}
+
+func TestRunConflictingEqualities(t *testing.T) {
+	// The server applies the equality it is sent.
+	client := &stubClient{tokens: []v2.Token{{Symbol: "A", HoldersCount: 10}}}
+	res, err := New().Where("holdersCount", Eq, 10).Where("holdersCount", Eq, 20).Run(context.Background(), client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Tokens) != 0 {
+		t.Errorf("%d tokens match two different holder counts", len(res.Tokens))
+	}
+	if want := []string{`{"holdersCount":{"eq":10}}`}; !reflect.DeepEqual(client.filters, want) {
+		t.Errorf("sent filters %v, want %v", client.filters, want)
+	}
+}
+
+// pagingClient serves a market of total tokens by offset and limit and
+// records the pages requested.
+type pagingClient struct {
+	total   int
+	offsets []int
+	limits  []int
+}
+
+func (c *pagingClient) Get(_ context.Context, _ string, params url.Values, result interface{}) error {
+	offset, _ := strconv.Atoi(params.Get("offset")) // absent when 0
+	limit, _ := strconv.Atoi(params.Get("limit"))
+	c.offsets = append(c.offsets, offset)
+	c.limits = append(c.limits, limit)
+	var page []v2.Token
+	for i := offset; i < min(offset+limit, c.total); i++ {
+		page = append(page, v2.Token{Symbol: strconv.Itoa(i), LiquidityUSD: 1e6})
+	}
+	result.(*v2.MarketQueryResponse).Data = page
+	return nil
+}
+
+func TestRunServerOnlyPaging(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   *Query
+		total   int
+		tokens  int
+		offsets []int
+		limits  []int
+		partial bool
+	}{
+		{
+			name:    "limit over a page",
+			query:   New().Where("liquidityUSD", Gt, 1000).Limit(250),
+			total:   1000,
+			tokens:  250,
+			offsets: []int{0, 100, 200},
+			limits:  []int{100, 100, 50},
+		},
+		{
+			name:    "limit within a page",
+			query:   New().Limit(30),
+			total:   1000,
+			tokens:  30,
+			offsets: []int{0},
+			limits:  []int{30},
+		},
+		{
+			name:    "server runs out",
+			query:   New().Limit(250),
+			total:   120,
+			tokens:  120,
+			offsets: []int{0, 100},
+			limits:  []int{100, 100},
+		},
+		{
+			name:    "no limit stops at MaxScan",
+			query:   New().MaxScan(150),
+			total:   1000,
+			tokens:  150,
+			offsets: []int{0, 100},
+			limits:  []int{100, 50},
+			partial: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &pagingClient{total: tt.total}
+			res, err := tt.query.Run(context.Background(), client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Tokens) != tt.tokens || res.Partial != tt.partial {
+				t.Errorf("%d tokens, partial %v; want %d, %v", len(res.Tokens), res.Partial, tt.tokens, tt.partial)
+			}
+			if !reflect.DeepEqual(client.offsets, tt.offsets) || !reflect.DeepEqual(client.limits, tt.limits) {
+				t.Errorf("requested offsets %v limits %v, want %v %v", client.offsets, client.limits, tt.offsets, tt.limits)
+			}
+		})
+	}
+}
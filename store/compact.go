@@ -0,0 +1,303 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/alert"
+)
+
+// OHLC summarizes the values of a field over a bar.
+type OHLC struct {
+	Open, High, Low, Close float64
+}
+
+// Bar summarizes the snapshots of a key over one bucket.
+type Bar struct {
+	Key         string
+	Start       time.Time
+	First, Last time.Time // times of the first and last snapshots
+	Count       int       // snapshots in the bucket
+	Fields      map[string]OHLC
+}
+
+// Bars returns the bars of key starting in [from, to), in time order. Bars of
+// days not compacted yet are computed from their snapshots, so the result is
+// the same before and after compaction.
+func (s *Store) Bars(key string, from, to time.Time) ([]Bar, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, err := s.scanDir()
+	if err != nil {
+		return nil, err
+	}
+	var out []Bar
+	for _, d := range files.days(from, to) {
+		compacted, snaps, err := s.dayData(d, files[d], key)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range mergeBars(compacted, Summarize(snaps, s.opts.Bucket)) {
+			if !b.Start.Before(from) && b.Start.Before(to) {
+				out = append(out, b)
+			}
+		}
+	}
+	return out, nil
+}
+
+// dayData reads the bars of day d and the snapshots not absorbed into them:
+// those of its raw segment and of segments a compaction set aside but did
+// not merge before it was interrupted.
+func (s *Store) dayData(d string, f *dayFiles, key string) ([]Bar, []alert.Snapshot, error) {
+	gen, compacted, err := readBars(s.path(d, barsExt), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	var paths []string
+	for _, g := range f.merges {
+		if g > gen {
+			paths = append(paths, s.mergePath(d, g))
+		}
+	}
+	if f.raw {
+		paths = append(paths, s.path(d, rawExt))
+	}
+	var snaps []alert.Snapshot
+	for _, path := range paths {
+		more, err := readRaw(path, key)
+		if err != nil {
+			return nil, nil, err
+		}
+		snaps = append(snaps, more...)
+	}
+	return compacted, snaps, nil
+}
+
+// Summarize groups snapshots into bars of bucket per key, ordered by start
+// then key. Open and Close are the first and last values of a field in time
+// order; fields missing from some snapshots only summarize the others.
+func Summarize(snaps []alert.Snapshot, bucket time.Duration) []Bar {
+	snaps = append([]alert.Snapshot(nil), snaps...)
+	sort.SliceStable(snaps, func(i, j int) bool { return snaps[i].Time.Before(snaps[j].Time) })
+
+	type barKey struct {
+		key   string
+		start int64
+	}
+	bars := map[barKey]*Bar{}
+	for _, snap := range snaps {
+		at := snap.Time.UTC()
+		start := at.Truncate(bucket)
+		k := barKey{snap.Key, start.UnixMilli()}
+		b := bars[k]
+		if b == nil {
+			b = &Bar{Key: snap.Key, Start: start, First: at, Fields: map[string]OHLC{}}
+			bars[k] = b
+		}
+		b.Last = at
+		b.Count++
+		for field, v := range snap.Values {
+			f, ok := b.Fields[field]
+			if !ok {
+				f = OHLC{Open: v, High: v, Low: v}
+			}
+			f.High = max(f.High, v)
+			f.Low = min(f.Low, v)
+			f.Close = v
+			b.Fields[field] = f
+		}
+	}
+
+	out := make([]Bar, 0, len(bars))
+	for _, b := range bars {
+		out = append(out, *b)
+	}
+	sortBars(out)
+	return out
+}
+
+// Compact applies the retention and compaction policies: days older than
+// Retention are deleted and days older than CompactAfter are replaced by
+// their bars. A day counts as old once its end is.
+func (s *Store) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Segments being rewritten or deleted must not stay open.
+	if err := s.closeSegments(); err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+
+	files, err := s.scanDir()
+	if err != nil {
+		return err
+	}
+	now := s.opts.Clock.Now()
+	var errs []error
+	for d, f := range files {
+		start, _ := time.Parse(time.DateOnly, d)
+		age := now.Sub(start.Add(24 * time.Hour))
+		switch {
+		case s.opts.Retention > 0 && age >= s.opts.Retention:
+			for _, path := range s.dayPaths(d, f) {
+				errs = append(errs, os.Remove(path))
+			}
+		case (f.raw || len(f.merges) > 0) && s.opts.CompactAfter > 0 && age >= s.opts.CompactAfter:
+			errs = append(errs, s.compactDay(d, f))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	return nil
+}
+
+// dayPaths lists the files of day d.
+func (s *Store) dayPaths(d string, f *dayFiles) []string {
+	var paths []string
+	if f.raw {
+		paths = append(paths, s.path(d, rawExt))
+	}
+	if f.bars {
+		paths = append(paths, s.path(d, barsExt))
+	}
+	for _, g := range f.merges {
+		paths = append(paths, s.mergePath(d, g))
+	}
+	return paths
+}
+
+// compactDay merges the raw snapshots of day d into its bars. The raw
+// segment is first set aside under the next generation, then the bars are
+// written with that generation, and only then is the segment removed, so a
+// crash at any point neither loses nor double counts snapshots.
+func (s *Store) compactDay(d string, f *dayFiles) error {
+	gen, bars, err := readBars(s.path(d, barsExt), "")
+	if err != nil {
+		return err
+	}
+
+	var merged []string
+	next := gen
+	for _, g := range f.merges {
+		path := s.mergePath(d, g)
+		if g <= gen {
+			// Already in the bars; the compaction stopped before removing it.
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			continue
+		}
+		merged = append(merged, path)
+		next = g
+	}
+	if f.raw {
+		next++
+		path := s.mergePath(d, next)
+		if err := os.Rename(s.path(d, rawExt), path); err != nil {
+			return err
+		}
+		merged = append(merged, path)
+	}
+
+	var snaps []alert.Snapshot
+	for _, path := range merged {
+		more, err := readRaw(path, "")
+		if err != nil {
+			return err
+		}
+		snaps = append(snaps, more...)
+	}
+	bars = mergeBars(bars, Summarize(snaps, s.opts.Bucket))
+
+	data, err := encodeBarsHeader(next)
+	if err != nil {
+		return err
+	}
+	for _, b := range bars {
+		line, err := encodeBar(b)
+		if err != nil {
+			return err
+		}
+		data = append(data, line...)
+	}
+	if err := writeAtomic(s.path(d, barsExt), data); err != nil {
+		return err
+	}
+	for _, path := range merged {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeBars combines compacted bars with bars summarized from later
+// snapshots. Bars of the same key and bucket are merged: the extremes of
+// both, the open of the one starting first, the close of the one ending
+// last, and the counts added.
+func mergeBars(old, new []Bar) []Bar {
+	type barKey struct {
+		key   string
+		start int64
+	}
+	index := map[barKey]int{}
+	out := append([]Bar(nil), old...)
+	for i, b := range out {
+		index[barKey{b.Key, b.Start.UnixMilli()}] = i
+	}
+	for _, b := range new {
+		k := barKey{b.Key, b.Start.UnixMilli()}
+		if i, ok := index[k]; ok {
+			out[i] = mergeBar(out[i], b)
+			continue
+		}
+		index[k] = len(out)
+		out = append(out, b)
+	}
+	sortBars(out)
+	return out
+}
+
+// mergeBar merges two bars of the same key and bucket.
+func mergeBar(a, b Bar) Bar {
+	m := Bar{Key: a.Key, Start: a.Start, First: a.First, Last: a.Last, Count: a.Count + b.Count, Fields: map[string]OHLC{}}
+	if b.First.Before(m.First) {
+		m.First = b.First
+	}
+	if b.Last.After(m.Last) {
+		m.Last = b.Last
+	}
+	for k, f := range a.Fields {
+		m.Fields[k] = f
+	}
+	for k, g := range b.Fields {
+		f, ok := m.Fields[k]
+		if !ok {
+			m.Fields[k] = g
+			continue
+		}
+		if b.First.Before(a.First) {
+			f.Open = g.Open
+		}
+		if !b.Last.Before(a.Last) {
+			f.Close = g.Close
+		}
+		f.High = max(f.High, g.High)
+		f.Low = min(f.Low, g.Low)
+		m.Fields[k] = f
+	}
+	return m
+}
+
+func sortBars(bars []Bar) {
+	sort.Slice(bars, func(i, j int) bool {
+		if !bars[i].Start.Equal(bars[j].Start) {
+			return bars[i].Start.Before(bars[j].Start)
+		}
+		return bars[i].Key < bars[j].Key
+	})
+}
@@ -0,0 +1,199 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/alert"
+)
+
+// Segments hold one JSON object per line: raw segments a snapshot, bar
+// files a bar with its fields as [open, high, low, close]. A bar file starts
+// with a header line giving the last merge generation it absorbed.
+type rawLine struct {
+	Key    string             `json:"k"`
+	Time   int64              `json:"t"` // Unix milliseconds
+	Values map[string]float64 `json:"v"`
+}
+
+type barLine struct {
+	Key    string                `json:"k"`
+	Start  int64                 `json:"t"` // Unix milliseconds
+	First  int64                 `json:"a"` // Unix milliseconds
+	Last   int64                 `json:"z"` // Unix milliseconds
+	Count  int                   `json:"n"`
+	Fields map[string][4]float64 `json:"f"`
+}
+
+type barsHeader struct {
+	Generation int `json:"gen"`
+}
+
+func encodeRaw(s alert.Snapshot) ([]byte, error) {
+	b, err := json.Marshal(rawLine{Key: s.Key, Time: s.Time.UnixMilli(), Values: s.Values})
+	return append(b, '\n'), err
+}
+
+func encodeBar(b Bar) ([]byte, error) {
+	fields := make(map[string][4]float64, len(b.Fields))
+	for k, f := range b.Fields {
+		fields[k] = [4]float64{f.Open, f.High, f.Low, f.Close}
+	}
+	data, err := json.Marshal(barLine{
+		Key: b.Key, Start: b.Start.UnixMilli(), First: b.First.UnixMilli(), Last: b.Last.UnixMilli(),
+		Count: b.Count, Fields: fields,
+	})
+	return append(data, '\n'), err
+}
+
+func encodeBarsHeader(gen int) ([]byte, error) {
+	data, err := json.Marshal(barsHeader{Generation: gen})
+	return append(data, '\n'), err
+}
+
+// readRaw reads the snapshots of key in the segment at path, or of every key
+// when key is empty. A missing file has none.
+func readRaw(path, key string) ([]alert.Snapshot, error) {
+	var out []alert.Snapshot
+	err := scan(path, func(data []byte) error {
+		var l rawLine
+		if err := json.Unmarshal(data, &l); err != nil {
+			return err
+		}
+		if key == "" || l.Key == key {
+			out = append(out, alert.Snapshot{Key: l.Key, Time: time.UnixMilli(l.Time).UTC(), Values: l.Values})
+		}
+		return nil
+	})
+	return out, err
+}
+
+// readBars reads the merge generation of the bar file at path and its bars
+// of key, or of every key when key is empty. A missing file has generation 0
+// and no bars.
+func readBars(path, key string) (int, []Bar, error) {
+	var (
+		gen   int
+		out   []Bar
+		first = true
+	)
+	err := scan(path, func(data []byte) error {
+		if first {
+			first = false
+			var h barsHeader
+			if err := json.Unmarshal(data, &h); err != nil {
+				return err
+			}
+			gen = h.Generation
+			return nil
+		}
+		var l barLine
+		if err := json.Unmarshal(data, &l); err != nil {
+			return err
+		}
+		if key != "" && l.Key != key {
+			return nil
+		}
+		b := Bar{
+			Key: l.Key, Start: time.UnixMilli(l.Start).UTC(),
+			First: time.UnixMilli(l.First).UTC(), Last: time.UnixMilli(l.Last).UTC(),
+			Count: l.Count, Fields: make(map[string]OHLC, len(l.Fields)),
+		}
+		for k, f := range l.Fields {
+			b.Fields[k] = OHLC{Open: f[0], High: f[1], Low: f[2], Close: f[3]}
+		}
+		out = append(out, b)
+		return nil
+	})
+	return gen, out, err
+}
+
+// scan calls fn with every complete line of the file at path. A last line
+// without a newline is a write cut short and is skipped.
+func scan(path string, fn func([]byte) error) error {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	for n := 1; ; n++ {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("store: reading %s: %w", path, err)
+		}
+		if err := fn(line); err != nil {
+			return fmt.Errorf("store: %s:%d: %w", path, n, err)
+		}
+	}
+}
+
+// trimPartial truncates the file at path after its last newline.
+func trimPartial(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	buf := make([]byte, 4096)
+	for end := size; end > 0; {
+		start := max(end-int64(len(buf)), 0)
+		n, err := f.ReadAt(buf[:end-start], start)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		for i := n - 1; i >= 0; i-- {
+			if buf[i] == '\n' {
+				if keep := start + int64(i) + 1; keep != size {
+					return f.Truncate(keep)
+				}
+				return nil
+			}
+		}
+		end = start
+	}
+	if size > 0 {
+		return f.Truncate(0)
+	}
+	return nil
+}
+
+// writeAtomic replaces the file at path with data.
+func writeAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,303 @@
+// Package store keeps a local history of token and pool snapshots.
+//
+// Snapshots are appended to one segment file per UTC day in a directory.
+// Old days are compacted into OHLC bars per field, and days past the
+// retention are deleted, which keeps a long history of metrics the API only
+// reports as current values, such as holdersCount and
+// top10HoldingsPercentage:
+//
+//	s, err := store.Open("snapshots", &store.Options{
+//		CompactAfter: 7 * 24 * time.Hour,
+//		Retention:    365 * 24 * time.Hour,
+//	})
+//	defer s.Close()
+//
+//	feed := make(chan alert.Snapshot)
+//	go alert.Poll(ctx, client, nil, time.Minute, tokens, feed)
+//	go s.Run(ctx, feed, time.Hour)
+//
+//	bars, err := s.Bars(alert.Key("ethereum", "0x..."), from, to)
+//	for _, b := range bars {
+//		fmt.Println(b.Start, b.Fields["holdersCount"].Close)
+//	}
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/alert"
+	"github.com/zomvs/mobula-go-sdk/clock"
+)
+
+// Options tune a Store.
+type Options struct {
+	// CompactAfter is the age after which a day of snapshots is replaced by
+	// its bars. Zero keeps raw snapshots until the retention. A snapshot
+	// arriving after its day was compacted is merged into the bar of its
+	// bucket on the next compaction.
+	CompactAfter time.Duration
+	// Retention is the age after which a day is deleted, raw or compacted.
+	// Zero keeps everything.
+	Retention time.Duration
+	// Bucket is the length of a bar, default 1h. It must divide a day.
+	Bucket time.Duration
+	// Sync flushes every Append to disk before it returns.
+	Sync  bool
+	Clock clock.Clock // default clock.System
+}
+
+// Store is an on-disk snapshot history. It is safe for concurrent use by
+// one process; several processes must not share a directory.
+type Store struct {
+	dir  string
+	opts Options
+
+	mu    sync.Mutex
+	files map[string]*os.File // open raw segments by day
+}
+
+// Open opens or creates the store in dir.
+func Open(dir string, opts *Options) (*Store, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Bucket == 0 {
+		o.Bucket = time.Hour
+	}
+	if o.Bucket < 0 || (24*time.Hour)%o.Bucket != 0 {
+		return nil, fmt.Errorf("store: bucket %s does not divide a day", o.Bucket)
+	}
+	if o.CompactAfter < 0 || o.Retention < 0 {
+		return nil, errors.New("store: negative compaction or retention age")
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	return &Store{dir: dir, opts: o, files: map[string]*os.File{}}, nil
+}
+
+// Append writes snapshots to the segments of their days. Values that are
+// NaN or infinite are dropped.
+func (s *Store) Append(snaps ...alert.Snapshot) error {
+	byDay := map[string][]byte{}
+	for _, snap := range snaps {
+		values := make(map[string]float64, len(snap.Values))
+		for k, v := range snap.Values {
+			if !math.IsNaN(v) && !math.IsInf(v, 0) {
+				values[k] = v
+			}
+		}
+		line, err := encodeRaw(alert.Snapshot{Key: snap.Key, Time: snap.Time, Values: values})
+		if err != nil {
+			return fmt.Errorf("store: %w", err)
+		}
+		d := day(snap.Time)
+		byDay[d] = append(byDay[d], line...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for d, data := range byDay {
+		f, err := s.segment(d)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(data); err != nil {
+			return fmt.Errorf("store: writing %s: %w", f.Name(), err)
+		}
+		if s.opts.Sync {
+			if err := f.Sync(); err != nil {
+				return fmt.Errorf("store: syncing %s: %w", f.Name(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// Range returns the raw snapshots of key with From <= Time < To, in time
+// order. Days already compacted only have bars; see Bars.
+func (s *Store) Range(key string, from, to time.Time) ([]alert.Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files, err := s.scanDir()
+	if err != nil {
+		return nil, err
+	}
+	var out []alert.Snapshot
+	for _, d := range files.days(from, to) {
+		_, snaps, err := s.dayData(d, files[d], key)
+		if err != nil {
+			return nil, err
+		}
+		for _, snap := range snaps {
+			if !snap.Time.Before(from) && snap.Time.Before(to) {
+				out = append(out, snap)
+			}
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Time.Before(out[j].Time) })
+	return out, nil
+}
+
+// Run appends the snapshots of feed until it is closed or ctx is done, and
+// compacts the store every compactEvery when it is positive.
+func (s *Store) Run(ctx context.Context, feed <-chan alert.Snapshot, compactEvery time.Duration) error {
+	var tick <-chan time.Time
+	if compactEvery > 0 {
+		tick = s.opts.Clock.After(compactEvery)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case snap, ok := <-feed:
+			if !ok {
+				return nil
+			}
+			if err := s.Append(snap); err != nil {
+				return err
+			}
+		case <-tick:
+			if err := s.Compact(); err != nil {
+				return err
+			}
+			tick = s.opts.Clock.After(compactEvery)
+		}
+	}
+}
+
+// Close closes the open segments.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeSegments()
+}
+
+func (s *Store) closeSegments() error {
+	var errs []error
+	for d, f := range s.files {
+		errs = append(errs, f.Close())
+		delete(s.files, d)
+	}
+	return errors.Join(errs...)
+}
+
+// segment returns the raw segment of day d opened for appending. A last line
+// cut short by a crash is removed first so the next line starts cleanly.
+func (s *Store) segment(d string) (*os.File, error) {
+	if f := s.files[d]; f != nil {
+		return f, nil
+	}
+	path := s.path(d, rawExt)
+	if err := trimPartial(path); err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	s.files[d] = f
+	return f, nil
+}
+
+// A day is stored in up to three kinds of files: the raw segment being
+// appended to, the bars of its compacted snapshots, and raw segments set
+// aside by a compaction as <day>.<generation>.merge. The bar file records the
+// last generation it absorbed, so a segment left behind by an interrupted
+// compaction is merged exactly once.
+const (
+	rawExt   = ".seg"
+	barsExt  = ".bars"
+	mergeExt = ".merge"
+)
+
+func (s *Store) path(d, ext string) string {
+	return filepath.Join(s.dir, d+ext)
+}
+
+func (s *Store) mergePath(d string, gen int) string {
+	return s.path(d, "."+strconv.Itoa(gen)+mergeExt)
+}
+
+// dayFiles are the files of one day in the store directory.
+type dayFiles struct {
+	raw, bars bool
+	merges    []int // generations of the segments set aside, ascending
+}
+
+// storeFiles are the files of the store directory by day.
+type storeFiles map[string]*dayFiles
+
+// scanDir lists the files of the store directory by day.
+func (s *Store) scanDir() (storeFiles, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	files := storeFiles{}
+	for _, e := range entries {
+		name := e.Name()
+		if len(name) < len(time.DateOnly) {
+			continue
+		}
+		d, rest := name[:len(time.DateOnly)], name[len(time.DateOnly):]
+		if _, err := time.Parse(time.DateOnly, d); err != nil {
+			continue
+		}
+		f := files[d]
+		if f == nil {
+			f = &dayFiles{}
+		}
+		switch {
+		case rest == rawExt:
+			f.raw = true
+		case rest == barsExt:
+			f.bars = true
+		case strings.HasPrefix(rest, ".") && strings.HasSuffix(rest, mergeExt):
+			gen, err := strconv.Atoi(strings.TrimSuffix(rest[1:], mergeExt))
+			if err != nil || gen <= 0 {
+				continue
+			}
+			f.merges = append(f.merges, gen)
+		default:
+			continue
+		}
+		files[d] = f
+	}
+	for _, f := range files {
+		sort.Ints(f.merges)
+	}
+	return files, nil
+}
+
+// days lists the days with files overlapping [from, to), in order.
+func (files storeFiles) days(from, to time.Time) []string {
+	var out []string
+	for d := range files {
+		start, _ := time.Parse(time.DateOnly, d)
+		if start.Before(to) && start.Add(24*time.Hour).After(from) {
+			out = append(out, d)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// day names the UTC day of t.
+func day(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
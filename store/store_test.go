@@ -0,0 +1,299 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/zomvs/mobula-go-sdk/alert"
+	"github.com/zomvs/mobula-go-sdk/clock"
+)
+
+const key = "ethereum:0x6982508145454ce325ddbe47a25d4ec3d2311933"
+
+var day1 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func snap(at time.Time, holders float64) alert.Snapshot {
+	return alert.Snapshot{Key: key, Time: at, Values: map[string]float64{"holdersCount": holders}}
+}
+
+func open(t *testing.T, dir string, clk *clock.Fake, opts Options) *Store {
+	t.Helper()
+	opts.Clock = clk
+	s, err := Open(dir, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+// files lists the store directory.
+func files(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func holders(snaps []alert.Snapshot) []float64 {
+	var out []float64
+	for _, s := range snaps {
+		out = append(out, s.Values["holdersCount"])
+	}
+	return out
+}
+
+func TestAppendRangeAcrossDays(t *testing.T) {
+	dir := t.TempDir()
+	s := open(t, dir, clock.NewFake(day1), Options{})
+	midnight := day1.Add(24 * time.Hour)
+	if err := s.Append(
+		snap(midnight.Add(time.Minute), 3),
+		snap(midnight.Add(-time.Minute), 2),
+		snap(midnight.Add(-time.Hour), 1),
+		alert.Snapshot{Key: "other", Time: midnight, Values: map[string]float64{"holdersCount": 9}},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"2026-01-01.seg", "2026-01-02.seg"}; !reflect.DeepEqual(files(t, dir), want) {
+		t.Errorf("files %v, want %v", files(t, dir), want)
+	}
+
+	got, err := s.Range(key, time.Time{}, midnight.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{1, 2, 3}; !reflect.DeepEqual(holders(got), want) {
+		t.Errorf("range %v, want %v", holders(got), want)
+	}
+	if !got[1].Time.Equal(midnight.Add(-time.Minute)) {
+		t.Errorf("time %s, want %s", got[1].Time, midnight.Add(-time.Minute))
+	}
+
+	got, err = s.Range(key, midnight.Add(-time.Minute), midnight.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{2}; !reflect.DeepEqual(holders(got), want) {
+		t.Errorf("half-open range %v, want %v", holders(got), want)
+	}
+}
+
+func TestTornLine(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewFake(day1)
+	s := open(t, dir, clk, Options{})
+	if err := s.Append(snap(day1.Add(time.Hour), 1)); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	// A crash cut the next line short.
+	path := filepath.Join(dir, "2026-01-01.seg")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"k":"` + key + `","t":17`)
+	f.Close()
+
+	s = open(t, dir, clk, Options{})
+	got, err := s.Range(key, day1, day1.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("torn line not skipped: %v", err)
+	}
+	if want := []float64{1}; !reflect.DeepEqual(holders(got), want) {
+		t.Errorf("range %v, want %v", holders(got), want)
+	}
+
+	if err := s.Append(snap(day1.Add(2*time.Hour), 2)); err != nil {
+		t.Fatal(err)
+	}
+	got, err = s.Range(key, day1, day1.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("torn line not trimmed before appending: %v", err)
+	}
+	if want := []float64{1, 2}; !reflect.DeepEqual(holders(got), want) {
+		t.Errorf("range after append %v, want %v", holders(got), want)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewFake(day1.Add(time.Hour))
+	s := open(t, dir, clk, Options{CompactAfter: 24 * time.Hour})
+	for i, v := range []float64{10, 14, 8, 12} {
+		if err := s.Append(snap(day1.Add(time.Duration(i)*15*time.Minute), v)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.Append(snap(day1.Add(90*time.Minute), 20)); err != nil {
+		t.Fatal(err)
+	}
+	before, err := s.Bars(key, time.Time{}, day1.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Bar{
+		{Key: key, Start: day1, First: day1, Last: day1.Add(45 * time.Minute), Count: 4,
+			Fields: map[string]OHLC{"holdersCount": {Open: 10, High: 14, Low: 8, Close: 12}}},
+		{Key: key, Start: day1.Add(time.Hour), First: day1.Add(90 * time.Minute), Last: day1.Add(90 * time.Minute), Count: 1,
+			Fields: map[string]OHLC{"holdersCount": {Open: 20, High: 20, Low: 20, Close: 20}}},
+	}
+	if !reflect.DeepEqual(before, want) {
+		t.Fatalf("bars %+v, want %+v", before, want)
+	}
+
+	// Not old enough yet: the day ends at midnight and must be a day older.
+	clk.Set(day1.Add(48*time.Hour - time.Second))
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if got := files(t, dir); !reflect.DeepEqual(got, []string{"2026-01-01.seg"}) {
+		t.Errorf("files %v before the day is old", got)
+	}
+
+	clk.Set(day1.Add(48 * time.Hour))
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if got := files(t, dir); !reflect.DeepEqual(got, []string{"2026-01-01.bars"}) {
+		t.Errorf("files %v after compaction, want only the bars", got)
+	}
+	after, err := s.Bars(key, time.Time{}, day1.Add(24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(after, before) {
+		t.Errorf("bars after compaction %+v, want %+v", after, before)
+	}
+	if raw, _ := s.Range(key, time.Time{}, day1.Add(24*time.Hour)); len(raw) != 0 {
+		t.Errorf("compacted day still has %d raw snapshots", len(raw))
+	}
+}
+
+func TestLateSnapshotMerges(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewFake(day1.Add(48 * time.Hour))
+	s := open(t, dir, clk, Options{CompactAfter: 24 * time.Hour})
+	if err := s.Append(snap(day1.Add(10*time.Minute), 10), snap(day1.Add(20*time.Minute), 14), snap(day1.Add(30*time.Minute), 12)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Late snapshots, one before and one after those compacted.
+	if err := s.Append(snap(day1.Add(5*time.Minute), 3), snap(day1.Add(40*time.Minute), 11)); err != nil {
+		t.Fatal(err)
+	}
+	want := []Bar{{
+		Key: key, Start: day1, First: day1.Add(5 * time.Minute), Last: day1.Add(40 * time.Minute), Count: 5,
+		Fields: map[string]OHLC{"holdersCount": {Open: 3, High: 14, Low: 3, Close: 11}},
+	}}
+	for _, step := range []string{"before", "after"} {
+		got, err := s.Bars(key, day1, day1.Add(24*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("bars %s compacting the late snapshots %+v, want %+v", step, got, want)
+		}
+		if err := s.Compact(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := files(t, dir); !reflect.DeepEqual(got, []string{"2026-01-01.bars"}) {
+		t.Errorf("files %v, want only the bars", got)
+	}
+}
+
+func TestInterruptedCompaction(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewFake(day1.Add(48 * time.Hour))
+	s := open(t, dir, clk, Options{CompactAfter: 24 * time.Hour})
+	if err := s.Append(snap(day1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	// Generation 1 went into the bars but was not removed; generation 2 was
+	// set aside but its bars never written.
+	seg := func(v float64) []byte {
+		line, err := encodeRaw(snap(day1.Add(time.Minute), v))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return line
+	}
+	os.WriteFile(filepath.Join(dir, "2026-01-01.1.merge"), seg(1), 0o644)
+	os.WriteFile(filepath.Join(dir, "2026-01-01.2.merge"), seg(2), 0o644)
+
+	s = open(t, dir, clk, Options{CompactAfter: 24 * time.Hour})
+	check := func(step string) {
+		t.Helper()
+		bars, err := s.Bars(key, day1, day1.Add(24*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bars) != 1 || bars[0].Count != 2 || bars[0].Fields["holdersCount"].Close != 2 {
+			t.Errorf("%s: bars %+v, want 2 snapshots closing at 2", step, bars)
+		}
+	}
+	check("before recovery")
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	check("after recovery")
+	if got := files(t, dir); !reflect.DeepEqual(got, []string{"2026-01-01.bars"}) {
+		t.Errorf("files %v, want only the bars", got)
+	}
+}
+
+func TestRetention(t *testing.T) {
+	dir := t.TempDir()
+	clk := clock.NewFake(day1)
+	s := open(t, dir, clk, Options{CompactAfter: 24 * time.Hour, Retention: 72 * time.Hour})
+	for i := range 4 {
+		if err := s.Append(snap(day1.Add(time.Duration(i)*24*time.Hour), float64(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	clk.Set(day1.Add(4 * 24 * time.Hour))
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"2026-01-02.bars", "2026-01-03.bars", "2026-01-04.seg"}
+	if got := files(t, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("files %v, want %v", got, want)
+	}
+
+	clk.Set(day1.Add(6 * 24 * time.Hour))
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if got := files(t, dir); !reflect.DeepEqual(got, []string{"2026-01-04.bars"}) {
+		t.Errorf("files %v, want only the last day", got)
+	}
+	clk.Set(day1.Add(7 * 24 * time.Hour))
+	if err := s.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if got := files(t, dir); len(got) != 0 {
+		t.Errorf("files %v past the retention", got)
+	}
+}
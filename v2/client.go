@@ -0,0 +1,33 @@
+package v2
+
+import (
+	"context"
+	"net/url"
+)
+
+// The endpoint functions and models of this package are generated from the
+// vendored OpenAPI document; edit api/openapi.json rather than the generated
+// files.
+//
+//go:generate go run ../internal/gen -spec ../api/openapi.json -out .
+
+// HTTPClient is the transport the read-only endpoint functions call
+// through. *mobula.Client implements it.
+type HTTPClient interface {
+	Get(ctx context.Context, path string, queryParams url.Values, result interface{}) error
+}
+
+// HTTPPoster is the transport of the endpoints taking a request body, such
+// as building and sending swaps. *mobula.Client implements it.
+type HTTPPoster interface {
+	HTTPClient
+	Post(ctx context.Context, path string, body interface{}, result interface{}) error
+}
+
+// Endpoint describes one generated endpoint.
+type Endpoint struct {
+	Name     string             // name of the path constant, e.g. "TokenSecurity"
+	Method   string             // HTTP method
+	Path     string             // request path
+	Response func() interface{} // returns a pointer to a new, empty response
+}
@@ -0,0 +1,25 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+// Endpoints lists every generated endpoint in spec order.
+var Endpoints = []Endpoint{
+	{Name: "TokenSecurity", Method: "GET", Path: TokenSecurity, Response: func() interface{} { return new(TokenSecurityResponse) }},
+	{Name: "TokenDetails", Method: "GET", Path: TokenDetails, Response: func() interface{} { return new(TokenDetailsResponse) }},
+	{Name: "AssetDetails", Method: "GET", Path: AssetDetails, Response: func() interface{} { return new(AssetDetailsResponse) }},
+	{Name: "MarketDetails", Method: "GET", Path: MarketDetails, Response: func() interface{} { return new(MarketDetailsResponse) }},
+	{Name: "TokenMarkets", Method: "GET", Path: TokenMarkets, Response: func() interface{} { return new(TokenMarketsResponse) }},
+	{Name: "Search", Method: "GET", Path: Search, Response: func() interface{} { return new(SearchResponse) }},
+	{Name: "Pulse", Method: "GET", Path: Pulse, Response: func() interface{} { return new(PulseResponse) }},
+	{Name: "MarketQuery", Method: "GET", Path: MarketQuery, Response: func() interface{} { return new(MarketQueryResponse) }},
+	{Name: "SwapQuote", Method: "GET", Path: SwapQuote, Response: func() interface{} { return new(SwapQuoteResponse) }},
+	{Name: "SwapTransaction", Method: "POST", Path: SwapTransaction, Response: func() interface{} { return new(SwapTransactionResponse) }},
+	{Name: "SwapSend", Method: "POST", Path: SwapSend, Response: func() interface{} { return new(SwapSendResponse) }},
+	{Name: "TokenOHLCVHistory", Method: "GET", Path: TokenOHLCVHistory, Response: func() interface{} { return new(TokenOHLCVHistoryResponse) }},
+	{Name: "TokenTrades", Method: "GET", Path: TokenTrades, Response: func() interface{} { return new(TokenTradesResponse) }},
+	{Name: "WalletPortfolio", Method: "GET", Path: WalletPortfolio, Response: func() interface{} { return new(WalletPortfolioResponse) }},
+	{Name: "WalletHistory", Method: "GET", Path: WalletHistory, Response: func() interface{} { return new(WalletHistoryResponse) }},
+	{Name: "WalletLabels", Method: "GET", Path: WalletLabels, Response: func() interface{} { return new(WalletLabelsResponse) }},
+	{Name: "WalletNFTs", Method: "GET", Path: WalletNFTs, Response: func() interface{} { return new(WalletNFTsResponse) }},
+	{Name: "NFTCollection", Method: "GET", Path: NFTCollection, Response: func() interface{} { return new(NFTCollectionResponse) }},
+}
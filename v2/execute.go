@@ -0,0 +1,140 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Signer signs swap transactions built by Mobula. The SDK never holds keys:
+// callers implement Signer over their own key management, be it a local
+// keystore, a hardware wallet or a remote signing service.
+type Signer interface {
+	// Address is the wallet the signer signs for.
+	Address() string
+	// Sign signs tx and returns it encoded for SwapSendRequest: a 0x-prefixed
+	// hex RLP transaction on EVM chains, a base64 transaction on Solana.
+	Sign(ctx context.Context, tx *SwapTransactionData) (string, error)
+}
+
+// Simulator dry-runs an unsigned swap transaction, typically against a fork
+// or an RPC simulation endpoint, or a fake in tests.
+type Simulator interface {
+	Simulate(ctx context.Context, tx *SwapTransactionData) (*Simulation, error)
+}
+
+// Simulation is the outcome of a dry run.
+type Simulation struct {
+	Success      bool
+	AmountOutRaw string // output the simulation observed, if known
+	GasUsed      int64
+	Logs         []string
+	Error        string // revert reason or program error when Success is false
+}
+
+// SimulationError is returned when a simulated swap fails; the transaction
+// is not signed.
+type SimulationError struct {
+	Simulation *Simulation
+}
+
+func (e *SimulationError) Error() string {
+	return "swap simulation failed: " + e.Simulation.Error
+}
+
+// ErrNoSigner is returned by ExecuteSwap when a transaction is to be sent
+// without a Signer.
+var ErrNoSigner = errors.New("swap: no signer")
+
+// ExecuteOptions tune ExecuteSwap.
+type ExecuteOptions struct {
+	Simulator Simulator // dry-run the transaction before signing it
+	DryRun    bool      // stop after building and simulating
+}
+
+// SwapExecution records the steps ExecuteSwap went through. Simulation is
+// nil without a Simulator, and Sent is nil for dry runs.
+type SwapExecution struct {
+	Transaction *SwapTransactionData
+	Simulation  *Simulation
+	Sent        *SwapSendData
+}
+
+// ExecuteSwap builds the transaction for req, simulates it when a Simulator
+// is set, then signs it with signer and broadcasts it. The wallet defaults to
+// the signer's address. On failure the returned execution holds the steps
+// completed so far.
+func ExecuteSwap(ctx context.Context, client HTTPPoster, req *SwapTransactionRequest, signer Signer, opts *ExecuteOptions) (*SwapExecution, error) {
+	var o ExecuteOptions
+	if opts != nil {
+		o = *opts
+	}
+	if signer == nil && !o.DryRun {
+		return nil, ErrNoSigner
+	}
+	if signer != nil {
+		if req.WalletAddress == "" {
+			r := *req
+			r.WalletAddress = signer.Address()
+			req = &r
+		} else if !strings.EqualFold(req.WalletAddress, signer.Address()) {
+			return nil, fmt.Errorf("swap: wallet %s does not match signer %s", req.WalletAddress, signer.Address())
+		}
+	}
+
+	built, err := BuildSwapTransaction(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	exec := &SwapExecution{Transaction: &built.Data}
+	if err := checkTransaction(exec.Transaction); err != nil {
+		return exec, err
+	}
+
+	if o.Simulator != nil {
+		sim, err := o.Simulator.Simulate(ctx, exec.Transaction)
+		if err != nil {
+			return exec, fmt.Errorf("swap simulation: %w", err)
+		}
+		exec.Simulation = sim
+		if !sim.Success {
+			return exec, &SimulationError{Simulation: sim}
+		}
+	}
+	if o.DryRun {
+		return exec, nil
+	}
+
+	signed, err := signer.Sign(ctx, exec.Transaction)
+	if err != nil {
+		return exec, fmt.Errorf("swap signing: %w", err)
+	}
+	sent, err := SendSwapTransaction(ctx, client, &SwapSendRequest{
+		ChainID:           exec.Transaction.ChainID,
+		SignedTransaction: signed,
+		RequestID:         exec.Transaction.RequestID,
+	})
+	if err != nil {
+		return exec, err
+	}
+	exec.Sent = &sent.Data
+	return exec, nil
+}
+
+// checkTransaction rejects built transactions missing the payload of their VM.
+func checkTransaction(tx *SwapTransactionData) error {
+	switch tx.VM {
+	case SwapVMEvm:
+		if tx.EVM.To == "" || tx.EVM.Data == "" {
+			return errors.New("swap: built EVM transaction has no call")
+		}
+	case SwapVMSolana:
+		if tx.Solana.Serialized == "" {
+			return errors.New("swap: built Solana transaction is empty")
+		}
+	default:
+		return fmt.Errorf("swap: unknown transaction vm %q", tx.VM)
+	}
+	return nil
+}
@@ -1,8 +1,11 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
 package v2
 
 import (
 	"context"
 	"net/url"
+	"strconv"
 )
 
 const (
@@ -18,16 +21,19 @@ const (
 	MarketDetails = "/api/2/market/details"
 	// TokenMarkets https://docs.mobula.io/rest-api-reference/endpoint/token-markets
 	TokenMarkets = "/api/2/token/markets"
+	// TokenOHLCVHistory https://docs.mobula.io/rest-api-reference/endpoint/token-ohlcv-history
+	TokenOHLCVHistory = "/api/2/token/ohlcv-history"
+	// TokenTrades https://docs.mobula.io/rest-api-reference/endpoint/token-trades
+	TokenTrades = "/api/2/token/trades"
 )
 
-type HTTPClient interface {
-	Get(ctx context.Context, path string, queryParams url.Values, result interface{}) error
-}
-
+// GetTokenSecurity retrieves security information for a token
 func GetTokenSecurity(ctx context.Context, client HTTPClient, req *TokenSecurityRequest) (*TokenSecurityResponse, error) {
 	params := url.Values{}
 	params.Set("address", req.Address)
-	params.Set("blockchain", req.Blockchain)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
 
 	var resp TokenSecurityResponse
 	if err := client.Get(ctx, TokenSecurity, params, &resp); err != nil {
@@ -37,10 +43,13 @@ func GetTokenSecurity(ctx context.Context, client HTTPClient, req *TokenSecurity
 	return &resp, nil
 }
 
+// GetTokenDetails retrieves detailed information for a token
 func GetTokenDetails(ctx context.Context, client HTTPClient, req *TokenDetailsRequest) (*TokenDetailsResponse, error) {
 	params := url.Values{}
 	params.Set("address", req.Address)
-	params.Set("blockchain", req.Blockchain)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
 
 	var resp TokenDetailsResponse
 	if err := client.Get(ctx, TokenDetails, params, &resp); err != nil {
@@ -50,10 +59,21 @@ func GetTokenDetails(ctx context.Context, client HTTPClient, req *TokenDetailsRe
 	return &resp, nil
 }
 
+// GetAssetDetails retrieves detailed metadata for an asset
 func GetAssetDetails(ctx context.Context, client HTTPClient, req *AssetDetailsRequest) (*AssetDetailsResponse, error) {
 	params := url.Values{}
-	params.Set("address", req.Address)
-	params.Set("blockchain", req.Blockchain)
+	if req.ID != nil {
+		params.Set("id", strconv.Itoa(*req.ID))
+	}
+	if req.Address != "" {
+		params.Set("address", req.Address)
+	}
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+	if req.TokensLimit != 0 {
+		params.Set("tokensLimit", strconv.Itoa(req.TokensLimit))
+	}
 
 	var resp AssetDetailsResponse
 	if err := client.Get(ctx, AssetDetails, params, &resp); err != nil {
@@ -63,10 +83,13 @@ func GetAssetDetails(ctx context.Context, client HTTPClient, req *AssetDetailsRe
 	return &resp, nil
 }
 
+// GetMarketDetails retrieves market details for an asset
 func GetMarketDetails(ctx context.Context, client HTTPClient, req *MarketDetailsRequest) (*MarketDetailsResponse, error) {
 	params := url.Values{}
-	params.Set("blockchain", req.Blockchain)
 	params.Set("address", req.Address)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
 
 	var resp MarketDetailsResponse
 	if err := client.Get(ctx, MarketDetails, params, &resp); err != nil {
@@ -76,11 +99,16 @@ func GetMarketDetails(ctx context.Context, client HTTPClient, req *MarketDetails
 	return &resp, nil
 }
 
+// GetTokenMarkets retrieves market data for a token
 func GetTokenMarkets(ctx context.Context, client HTTPClient, req *TokenMarketsRequest) (*TokenMarketsResponse, error) {
 	params := url.Values{}
 	params.Set("address", req.Address)
-	params.Set("blockchain", req.Blockchain)
-	params.Set("limit", req.Limit)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+	if req.Limit != "" {
+		params.Set("limit", req.Limit)
+	}
 
 	var resp TokenMarketsResponse
 	if err := client.Get(ctx, TokenMarkets, params, &resp); err != nil {
@@ -89,3 +117,59 @@ func GetTokenMarkets(ctx context.Context, client HTTPClient, req *TokenMarketsRe
 
 	return &resp, nil
 }
+
+// GetTokenOHLCVHistory retrieves price candles for a token over a time range
+func GetTokenOHLCVHistory(ctx context.Context, client HTTPClient, req *TokenOHLCVHistoryRequest) (*TokenOHLCVHistoryResponse, error) {
+	params := url.Values{}
+	params.Set("address", req.Address)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+	if req.From != 0 {
+		params.Set("from", strconv.FormatInt(req.From, 10))
+	}
+	if req.To != 0 {
+		params.Set("to", strconv.FormatInt(req.To, 10))
+	}
+	if req.Period != "" {
+		params.Set("period", string(req.Period))
+	}
+	if req.Amount != 0 {
+		params.Set("amount", strconv.Itoa(req.Amount))
+	}
+
+	var resp TokenOHLCVHistoryResponse
+	if err := client.Get(ctx, TokenOHLCVHistory, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetTokenTrades retrieves the swaps of a token over a time range
+func GetTokenTrades(ctx context.Context, client HTTPClient, req *TokenTradesRequest) (*TokenTradesResponse, error) {
+	params := url.Values{}
+	params.Set("address", req.Address)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+	if req.From != 0 {
+		params.Set("from", strconv.FormatInt(req.From, 10))
+	}
+	if req.To != 0 {
+		params.Set("to", strconv.FormatInt(req.To, 10))
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Offset != 0 {
+		params.Set("offset", strconv.Itoa(req.Offset))
+	}
+
+	var resp TokenTradesResponse
+	if err := client.Get(ctx, TokenTrades, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,75 @@
+package v2
+
+import "context"
+
+// WalletNFTIterator walks the NFTs of a wallet across pages:
+//
+//	it := v2.NewWalletNFTIterator(client, &v2.WalletNFTsRequest{Wallet: wallet})
+//	for it.Next(ctx) {
+//		nft := it.NFT()
+//		...
+//	}
+//	if err := it.Err(); err != nil { ... }
+//
+// Pages are fetched as the iteration reaches them. Cursor can be saved to
+// resume a walk later by setting it on the request.
+type WalletNFTIterator struct {
+	client HTTPClient
+	req    WalletNFTsRequest
+	page   []NFT
+	pos    int
+	done   bool
+	err    error
+	seen   map[string]bool // cursors fetched
+}
+
+// NewWalletNFTIterator creates an iterator starting at req.Cursor.
+func NewWalletNFTIterator(client HTTPClient, req *WalletNFTsRequest) *WalletNFTIterator {
+	return &WalletNFTIterator{client: client, req: *req, pos: -1, seen: map[string]bool{}}
+}
+
+// Next advances to the next NFT, fetching the next page when needed. It
+// returns false at the end of the holdings or on error.
+func (it *WalletNFTIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.pos++
+	for it.pos >= len(it.page) {
+		if it.done {
+			return false
+		}
+		resp, err := GetWalletNFTs(ctx, it.client, &it.req)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.seen[it.req.Cursor] = true
+		it.page, it.pos = resp.Data, 0
+		// A page without NFTs or a cursor pointing back at a page already
+		// fetched ends the walk, which would otherwise loop forever.
+		it.done = resp.NextCursor == "" || it.seen[resp.NextCursor] || len(resp.Data) == 0
+		it.req.Cursor = resp.NextCursor
+		if it.done {
+			it.req.Cursor = ""
+		}
+	}
+	return true
+}
+
+// NFT returns the current NFT.
+func (it *WalletNFTIterator) NFT() NFT {
+	return it.page[it.pos]
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *WalletNFTIterator) Err() error {
+	return it.err
+}
+
+// Cursor returns the cursor of the page after the current one, empty once
+// the last page has been fetched or the walk stopped on an empty page or a
+// cursor seen before.
+func (it *WalletNFTIterator) Cursor() string {
+	return it.req.Cursor
+}
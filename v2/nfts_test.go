@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+// pagesClient serves wallet NFT pages by cursor and counts the requests.
+type pagesClient struct {
+	pages map[string]WalletNFTsResponse
+	calls int
+}
+
+func (c *pagesClient) Get(_ context.Context, _ string, params url.Values, result interface{}) error {
+	if c.calls++; c.calls > 10 {
+		return errors.New("too many requests")
+	}
+	page, ok := c.pages[params.Get("cursor")]
+	if !ok {
+		return errors.New("unknown cursor " + params.Get("cursor"))
+	}
+	*result.(*WalletNFTsResponse) = page
+	return nil
+}
+
+func nfts(ids ...string) []NFT {
+	out := make([]NFT, len(ids))
+	for i, id := range ids {
+		out[i].TokenID = id
+	}
+	return out
+}
+
+func TestWalletNFTIterator(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages map[string]WalletNFTsResponse
+		want  []string
+		calls int
+	}{
+		{
+			name: "last page",
+			pages: map[string]WalletNFTsResponse{
+				"":  {Data: nfts("1", "2"), NextCursor: "a"},
+				"a": {Data: nfts("3")},
+			},
+			want:  []string{"1", "2", "3"},
+			calls: 2,
+		},
+		{
+			name: "repeated cursor",
+			pages: map[string]WalletNFTsResponse{
+				"":  {Data: nfts("1"), NextCursor: "a"},
+				"a": {Data: nfts("2"), NextCursor: "a"},
+			},
+			want:  []string{"1", "2"},
+			calls: 2,
+		},
+		{
+			name: "cursor back to an earlier page",
+			pages: map[string]WalletNFTsResponse{
+				"":  {Data: nfts("1"), NextCursor: "a"},
+				"a": {Data: nfts("2"), NextCursor: "b"},
+				"b": {Data: nfts("3"), NextCursor: "a"},
+			},
+			want:  []string{"1", "2", "3"},
+			calls: 3,
+		},
+		{
+			name: "empty page with a cursor",
+			pages: map[string]WalletNFTsResponse{
+				"":  {Data: nfts("1"), NextCursor: "a"},
+				"a": {Data: nfts(), NextCursor: "b"},
+			},
+			want:  []string{"1"},
+			calls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &pagesClient{pages: tt.pages}
+			it := NewWalletNFTIterator(client, &WalletNFTsRequest{Wallet: "0x1"})
+			var got []string
+			for it.Next(context.Background()) {
+				got = append(got, it.NFT().TokenID)
+			}
+			if err := it.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NFTs %v, want %v", got, tt.want)
+			}
+			if client.calls != tt.calls {
+				t.Errorf("%d requests, want %d", client.calls, tt.calls)
+			}
+			if it.Cursor() != "" {
+				t.Errorf("cursor %q after the walk ended", it.Cursor())
+			}
+			if it.Next(context.Background()) {
+				t.Error("Next succeeded after the walk ended")
+			}
+		})
+	}
+}
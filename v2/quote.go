@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QuoteLimits bound the swap quotes CheckQuote accepts. Zero fields are not
+// checked.
+type QuoteLimits struct {
+	MaxPriceImpactPercentage float64 // refuse quotes that move the price more than this
+	MaxTokenFeePercentage    float64 // refuse tokens whose buy or sell fee is above this
+	MinLiquidityUSD          float64 // refuse tokens whose main pool holds less than this
+}
+
+// QuoteRejectedError is returned for a quote that breaks its QuoteLimits.
+type QuoteRejectedError struct {
+	Quote   *SwapQuoteData
+	Reasons []string
+}
+
+func (e *QuoteRejectedError) Error() string {
+	return "swap quote rejected: " + strings.Join(e.Reasons, "; ")
+}
+
+// QuoteLeg is what is known of one token of a swap quote: its declared fees
+// and its main pool. Either may be nil, in which case the limits relying on
+// it are not checked for that token.
+type QuoteLeg struct {
+	Security *TokenSecurityData
+	Market   *Market
+}
+
+// CheckQuote checks q against limits, with in describing the sold token and
+// out the bought one. The returned error is a *QuoteRejectedError listing
+// every broken limit.
+func CheckQuote(q *SwapQuoteData, in, out QuoteLeg, limits QuoteLimits) error {
+	var reasons []string
+	if limits.MaxPriceImpactPercentage > 0 && q.PriceImpactPercentage > limits.MaxPriceImpactPercentage {
+		reasons = append(reasons, fmt.Sprintf("price impact %g%% exceeds %g%%", q.PriceImpactPercentage, limits.MaxPriceImpactPercentage))
+	}
+	reasons = append(reasons, in.check(q.TokenIn.Symbol, limits)...)
+	reasons = append(reasons, out.check(q.TokenOut.Symbol, limits)...)
+	if len(reasons) > 0 {
+		return &QuoteRejectedError{Quote: q, Reasons: reasons}
+	}
+	return nil
+}
+
+// check lists the limits the token named symbol breaks.
+func (l QuoteLeg) check(symbol string, limits QuoteLimits) []string {
+	var reasons []string
+	if l.Security != nil && limits.MaxTokenFeePercentage > 0 {
+		if l.Security.BuyFeePercentage > limits.MaxTokenFeePercentage {
+			reasons = append(reasons, fmt.Sprintf("%s buy fee %g%% exceeds %g%%", symbol, l.Security.BuyFeePercentage, limits.MaxTokenFeePercentage))
+		}
+		if l.Security.SellFeePercentage > limits.MaxTokenFeePercentage {
+			reasons = append(reasons, fmt.Sprintf("%s sell fee %g%% exceeds %g%%", symbol, l.Security.SellFeePercentage, limits.MaxTokenFeePercentage))
+		}
+	}
+	if l.Market != nil && limits.MinLiquidityUSD > 0 && l.Market.LiquidityUSD < limits.MinLiquidityUSD {
+		reasons = append(reasons, fmt.Sprintf("%s main pool liquidity $%.0f is below $%.0f", symbol, l.Market.LiquidityUSD, limits.MinLiquidityUSD))
+	}
+	return reasons
+}
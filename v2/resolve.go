@@ -0,0 +1,151 @@
+package v2
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+)
+
+// ResolveOptions tune ResolveSymbol and RankCandidates.
+type ResolveOptions struct {
+	Blockchains     []string // restrict candidates to these chains
+	MinLiquidityUSD float64  // ignore tokens with less liquidity
+	// AmbiguityRatio is how far ahead the winner must score for the result
+	// to be unambiguous; the default of 2 requires twice the runner-up score.
+	AmbiguityRatio float64
+}
+
+// Candidate is a token considered by the resolver with its score.
+type Candidate struct {
+	SearchResult
+	Match float64 // how well the symbol or name matches the query, 0 to 1
+	Score float64 // Match weighted by liquidity and volume
+}
+
+// Resolution is the outcome of resolving a symbol. Token is nil when nothing
+// matched. When Ambiguous is set the caller should not trust Token blindly:
+// another candidate scored within AmbiguityRatio of it.
+type Resolution struct {
+	Query      string
+	Token      *Candidate
+	Candidates []Candidate // ranked, best first
+	Ambiguous  bool
+	Margin     float64 // winner score divided by runner-up score, +Inf without a runner-up
+}
+
+// ResolveSymbol searches for symbol and picks the canonical token among the
+// results.
+func ResolveSymbol(ctx context.Context, client HTTPClient, symbol string, opts *ResolveOptions) (*Resolution, error) {
+	req := &SearchRequest{Input: symbol, Type: SearchResultTypeToken, Limit: 50}
+	if opts != nil {
+		req.Blockchains = opts.Blockchains
+	}
+	resp, err := GetSearch(ctx, client, req)
+	if err != nil {
+		return nil, err
+	}
+	return RankCandidates(symbol, resp.Data, opts), nil
+}
+
+// RankCandidates scores token results for query. A token's score is its match
+// quality weighted by the order of magnitude of its liquidity and 24h
+// volume, so the deep, active deployment of a symbol beats copycats with the
+// same ticker.
+func RankCandidates(query string, results []SearchResult, opts *ResolveOptions) *Resolution {
+	var o ResolveOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.AmbiguityRatio <= 0 {
+		o.AmbiguityRatio = 2
+	}
+
+	res := &Resolution{Query: query, Candidates: []Candidate{}, Margin: math.Inf(1)}
+	for _, r := range results {
+		if r.Type != "" && r.Type != SearchResultTypeToken {
+			continue
+		}
+		if r.LiquidityUSD < o.MinLiquidityUSD || !onChain(r, o.Blockchains) {
+			continue
+		}
+		match := math.Max(MatchScore(query, r.Symbol), 0.8*MatchScore(query, r.Name))
+		if match == 0 {
+			continue
+		}
+		weight := math.Log10(1+r.LiquidityUSD) + 0.5*math.Log10(1+r.Volume24HUSD)
+		res.Candidates = append(res.Candidates, Candidate{SearchResult: r, Match: match, Score: match * (1 + weight)})
+	}
+
+	sort.SliceStable(res.Candidates, func(i, j int) bool {
+		return res.Candidates[i].Score > res.Candidates[j].Score
+	})
+	if len(res.Candidates) == 0 {
+		return res
+	}
+	res.Token = &res.Candidates[0]
+	if len(res.Candidates) > 1 {
+		runnerUp := res.Candidates[1].Score
+		if runnerUp > 0 {
+			res.Margin = res.Token.Score / runnerUp
+		}
+		res.Ambiguous = res.Margin < o.AmbiguityRatio
+	}
+	return res
+}
+
+// MatchScore rates how well s matches query, from 0 (unrelated) to 1 (equal
+// ignoring case). Prefix and substring matches score in between, and other
+// strings score by edit distance when it is small.
+func MatchScore(query, s string) float64 {
+	q := strings.ToLower(strings.TrimSpace(query))
+	t := strings.ToLower(strings.TrimSpace(s))
+	switch {
+	case q == "" || t == "":
+		return 0
+	case q == t:
+		return 1
+	case strings.HasPrefix(t, q):
+		return 0.7 * float64(len(q)) / float64(len(t))
+	case strings.Contains(t, q):
+		return 0.5 * float64(len(q)) / float64(len(t))
+	}
+	d := levenshtein(q, t)
+	longest := max(len(q), len(t))
+	if d*3 > longest {
+		return 0
+	}
+	return 0.4 * (1 - float64(d)/float64(longest))
+}
+
+func onChain(r SearchResult, chains []string) bool {
+	if len(chains) == 0 {
+		return true
+	}
+	for _, c := range chains {
+		if strings.EqualFold(c, r.Blockchain) || strings.EqualFold(c, r.ChainID) {
+			return true
+		}
+	}
+	return false
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,125 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Search & Discovery
+
+	// Search https://docs.mobula.io/rest-api-reference/endpoint/fast-search
+	Search = "/api/2/fast-search"
+	// Pulse https://docs.mobula.io/rest-api-reference/endpoint/pulse
+	Pulse = "/api/2/pulse"
+	// MarketQuery https://docs.mobula.io/rest-api-reference/endpoint/market-query
+	MarketQuery = "/api/2/market/query"
+)
+
+// GetSearch searches assets, tokens and pools by name, symbol or address
+func GetSearch(ctx context.Context, client HTTPClient, req *SearchRequest) (*SearchResponse, error) {
+	params := url.Values{}
+	params.Set("input", req.Input)
+	if req.Type != "" {
+		params.Set("type", string(req.Type))
+	}
+	if len(req.Blockchains) > 0 {
+		params.Set("blockchains", strings.Join(req.Blockchains, ","))
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+
+	var resp SearchResponse
+	if err := client.Get(ctx, Search, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetPulse retrieves a Pulse discovery feed of trending and newly launched tokens
+func GetPulse(ctx context.Context, client HTTPClient, req *PulseRequest) (*PulseResponse, error) {
+	params := url.Values{}
+	params.Set("view", string(req.View))
+	if len(req.Blockchains) > 0 {
+		params.Set("blockchains", strings.Join(req.Blockchains, ","))
+	}
+	if len(req.Factories) > 0 {
+		params.Set("factories", strings.Join(req.Factories, ","))
+	}
+	if req.MinLiquidityUSD != 0 {
+		params.Set("minLiquidityUSD", strconv.FormatFloat(req.MinLiquidityUSD, 'f', -1, 64))
+	}
+	if req.MinVolume24HUSD != 0 {
+		params.Set("minVolume24hUSD", strconv.FormatFloat(req.MinVolume24HUSD, 'f', -1, 64))
+	}
+	if req.MinMarketCapUSD != 0 {
+		params.Set("minMarketCapUSD", strconv.FormatFloat(req.MinMarketCapUSD, 'f', -1, 64))
+	}
+	if req.MaxMarketCapUSD != 0 {
+		params.Set("maxMarketCapUSD", strconv.FormatFloat(req.MaxMarketCapUSD, 'f', -1, 64))
+	}
+	if req.MinHoldersCount != 0 {
+		params.Set("minHoldersCount", strconv.Itoa(req.MinHoldersCount))
+	}
+	if req.MaxTop10HoldingsPercentage != 0 {
+		params.Set("maxTop10HoldingsPercentage", strconv.FormatFloat(req.MaxTop10HoldingsPercentage, 'f', -1, 64))
+	}
+	if req.MaxDevHoldingsPercentage != 0 {
+		params.Set("maxDevHoldingsPercentage", strconv.FormatFloat(req.MaxDevHoldingsPercentage, 'f', -1, 64))
+	}
+	if req.MaxSnipersHoldingsPercentage != 0 {
+		params.Set("maxSnipersHoldingsPercentage", strconv.FormatFloat(req.MaxSnipersHoldingsPercentage, 'f', -1, 64))
+	}
+	if req.MinBondingPercentage != 0 {
+		params.Set("minBondingPercentage", strconv.FormatFloat(req.MinBondingPercentage, 'f', -1, 64))
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Offset != 0 {
+		params.Set("offset", strconv.Itoa(req.Offset))
+	}
+
+	var resp PulseResponse
+	if err := client.Get(ctx, Pulse, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetMarketQuery lists tokens matching server-side filters, sorted and paginated
+func GetMarketQuery(ctx context.Context, client HTTPClient, req *MarketQueryRequest) (*MarketQueryResponse, error) {
+	params := url.Values{}
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+	if req.Filters != "" {
+		params.Set("filters", req.Filters)
+	}
+	if req.SortBy != "" {
+		params.Set("sortBy", req.SortBy)
+	}
+	if req.SortOrder != "" {
+		params.Set("sortOrder", string(req.SortOrder))
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.Offset != 0 {
+		params.Set("offset", strconv.Itoa(req.Offset))
+	}
+
+	var resp MarketQueryResponse
+	if err := client.Get(ctx, MarketQuery, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,99 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import "time"
+
+// ========================
+// Search API Types
+// ========================
+
+type SearchRequest struct {
+	Input       string           `json:"input"`                 // Name, symbol or address to search for (required)
+	Type        SearchResultType `json:"type,omitempty"`        // Restrict results to assets, tokens or pools (optional)
+	Blockchains []string         `json:"blockchains,omitempty"` // Blockchains to search (optional)
+	Limit       int              `json:"limit,omitempty"`       // Max number of results (optional)
+}
+type SearchResponse struct {
+	Data []SearchResult `json:"data"`
+}
+
+// ========================
+// Pulse API Types
+// ========================
+
+type PulseRequest struct {
+	View                         PulseView `json:"view"`                                   // Feed to return (required)
+	Blockchains                  []string  `json:"blockchains,omitempty"`                  // Blockchains to include (optional)
+	Factories                    []string  `json:"factories,omitempty"`                    // Launchpad or DEX factories to include (optional)
+	MinLiquidityUSD              float64   `json:"minLiquidityUSD,omitempty"`              // Minimum liquidity in USD (optional)
+	MinVolume24HUSD              float64   `json:"minVolume24hUSD,omitempty"`              // Minimum 24h volume in USD (optional)
+	MinMarketCapUSD              float64   `json:"minMarketCapUSD,omitempty"`              // Minimum market cap in USD (optional)
+	MaxMarketCapUSD              float64   `json:"maxMarketCapUSD,omitempty"`              // Maximum market cap in USD (optional)
+	MinHoldersCount              int       `json:"minHoldersCount,omitempty"`              // Minimum number of holders (optional)
+	MaxTop10HoldingsPercentage   float64   `json:"maxTop10HoldingsPercentage,omitempty"`   // Maximum share of supply held by the top 10 holders (optional)
+	MaxDevHoldingsPercentage     float64   `json:"maxDevHoldingsPercentage,omitempty"`     // Maximum share of supply held by the deployer (optional)
+	MaxSnipersHoldingsPercentage float64   `json:"maxSnipersHoldingsPercentage,omitempty"` // Maximum share of supply held by snipers (optional)
+	MinBondingPercentage         float64   `json:"minBondingPercentage,omitempty"`         // Minimum bonding curve progress (optional)
+	Limit                        int       `json:"limit,omitempty"`                        // Max number of tokens to return (optional)
+	Offset                       int       `json:"offset,omitempty"`                       // Number of tokens to skip (optional)
+}
+type PulseResponse struct {
+	Data []Token `json:"data"`
+}
+
+// ========================
+// Market Query API Types
+// ========================
+
+type MarketQueryRequest struct {
+	Blockchain string    `json:"blockchain,omitempty"` // Blockchain to query (optional)
+	Filters    string    `json:"filters,omitempty"`    // JSON object of field filters, e.g. {"liquidityUSD":{"gte":50000}} (optional)
+	SortBy     string    `json:"sortBy,omitempty"`     // Field to sort by (optional)
+	SortOrder  SortOrder `json:"sortOrder,omitempty"`  // Sort direction (optional)
+	Limit      int       `json:"limit,omitempty"`      // Max number of tokens to return (optional, max: 100)
+	Offset     int       `json:"offset,omitempty"`     // Number of tokens to skip (optional)
+}
+type MarketQueryResponse struct {
+	Data []Token `json:"data"`
+}
+
+// SearchResultType is the kind of entity a search result refers to.
+type SearchResultType string
+
+const (
+	SearchResultTypeAsset SearchResultType = "asset"
+	SearchResultTypeToken SearchResultType = "token"
+	SearchResultTypePool  SearchResultType = "pool"
+)
+
+// SearchResult is one match of a search.
+type SearchResult struct {
+	Type         SearchResultType `json:"type"`
+	ID           int              `json:"id"` // Asset ID, set for assets and for tokens attached to an asset
+	Name         string           `json:"name"`
+	Symbol       string           `json:"symbol"`
+	Logo         string           `json:"logo"`
+	Address      string           `json:"address"` // Token or pool address, empty for assets
+	ChainID      string           `json:"chainId"`
+	Blockchain   string           `json:"blockchain"`
+	PriceUSD     float64          `json:"priceUSD"`
+	MarketCapUSD float64          `json:"marketCapUSD"`
+	LiquidityUSD float64          `json:"liquidityUSD"`
+	Volume24HUSD float64          `json:"volume24hUSD"`
+	HoldersCount int              `json:"holdersCount"`
+	CreatedAt    time.Time        `json:"createdAt"`
+	Score        float64          `json:"score"` // Relevance score assigned by the API
+}
+
+// PulseView selects a Pulse feed.
+type PulseView string
+
+const (
+	PulseViewTrending     PulseView = "trending"
+	PulseViewGainers      PulseView = "gainers"
+	PulseViewLosers       PulseView = "losers"
+	PulseViewNew          PulseView = "new"
+	PulseViewFinalStretch PulseView = "final-stretch"
+	PulseViewMigrated     PulseView = "migrated"
+)
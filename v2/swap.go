@@ -0,0 +1,65 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// Swap & Trading
+
+	// SwapQuote https://docs.mobula.io/rest-api-reference/endpoint/swap-quoting
+	SwapQuote = "/api/2/swap/quoting"
+	// SwapTransaction https://docs.mobula.io/rest-api-reference/endpoint/swap-transaction
+	SwapTransaction = "/api/2/swap/transaction"
+	// SwapSend https://docs.mobula.io/rest-api-reference/endpoint/swap-send
+	SwapSend = "/api/2/swap/send"
+)
+
+// GetSwapQuote retrieves a swap quote with its route, expected output and fees
+func GetSwapQuote(ctx context.Context, client HTTPClient, req *SwapQuoteRequest) (*SwapQuoteResponse, error) {
+	params := url.Values{}
+	params.Set("chainId", req.ChainID)
+	params.Set("tokenIn", req.TokenIn)
+	params.Set("tokenOut", req.TokenOut)
+	if req.Amount != "" {
+		params.Set("amount", req.Amount)
+	}
+	if req.AmountRaw != "" {
+		params.Set("amountRaw", req.AmountRaw)
+	}
+	if req.Slippage != 0 {
+		params.Set("slippage", strconv.FormatFloat(req.Slippage, 'f', -1, 64))
+	}
+	params.Set("walletAddress", req.WalletAddress)
+
+	var resp SwapQuoteResponse
+	if err := client.Get(ctx, SwapQuote, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// BuildSwapTransaction builds the unsigned transaction executing a swap
+func BuildSwapTransaction(ctx context.Context, client HTTPPoster, req *SwapTransactionRequest) (*SwapTransactionResponse, error) {
+	var resp SwapTransactionResponse
+	if err := client.Post(ctx, SwapTransaction, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// SendSwapTransaction broadcasts a signed swap transaction
+func SendSwapTransaction(ctx context.Context, client HTTPPoster, req *SwapSendRequest) (*SwapSendResponse, error) {
+	var resp SwapSendResponse
+	if err := client.Post(ctx, SwapSend, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,157 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import "time"
+
+// ========================
+// Swap Quote API Types
+// ========================
+
+type SwapQuoteRequest struct {
+	ChainID       string  `json:"chainId"`             // Chain identifier, e.g. evm:1 or solana (required)
+	TokenIn       string  `json:"tokenIn"`             // Address of the token to sell (required)
+	TokenOut      string  `json:"tokenOut"`            // Address of the token to buy (required)
+	Amount        string  `json:"amount,omitempty"`    // Amount to sell in token units, as a decimal string (optional, amount or amountRaw required)
+	AmountRaw     string  `json:"amountRaw,omitempty"` // Amount to sell in the smallest unit of the token (optional, amount or amountRaw required)
+	Slippage      float64 `json:"slippage,omitempty"`  // Maximum slippage in percent (optional, default: 1)
+	WalletAddress string  `json:"walletAddress"`       // Wallet that will execute the swap (required)
+}
+type SwapQuoteResponse struct {
+	Data SwapQuoteData `json:"data"`
+}
+
+// ========================
+// Swap Transaction API Types
+// ========================
+
+type SwapTransactionRequest struct {
+	ChainID       string  `json:"chainId"`
+	TokenIn       string  `json:"tokenIn"`
+	TokenOut      string  `json:"tokenOut"`
+	Amount        string  `json:"amount,omitempty"`
+	AmountRaw     string  `json:"amountRaw,omitempty"`
+	Slippage      float64 `json:"slippage,omitempty"`
+	WalletAddress string  `json:"walletAddress"`
+	RequestID     string  `json:"requestId,omitempty"`
+}
+type SwapTransactionResponse struct {
+	Data SwapTransactionData `json:"data"`
+}
+
+// ========================
+// Swap Send API Types
+// ========================
+
+type SwapSendRequest struct {
+	ChainID           string `json:"chainId"`
+	SignedTransaction string `json:"signedTransaction"`
+	RequestID         string `json:"requestId,omitempty"`
+}
+type SwapSendResponse struct {
+	Data SwapSendData `json:"data"`
+}
+
+// SwapQuoteData is a priced route for selling one token for another.
+type SwapQuoteData struct {
+	RequestID             string         `json:"requestId"`
+	ChainID               string         `json:"chainId"`
+	TokenIn               SwapToken      `json:"tokenIn"`
+	TokenOut              SwapToken      `json:"tokenOut"`
+	AmountInTokens        string         `json:"amountInTokens"`
+	AmountInRaw           string         `json:"amountInRaw"`
+	AmountOutTokens       string         `json:"amountOutTokens"`
+	AmountOutRaw          string         `json:"amountOutRaw"`
+	MinAmountOutTokens    string         `json:"minAmountOutTokens"`
+	MinAmountOutRaw       string         `json:"minAmountOutRaw"`
+	AmountInUSD           float64        `json:"amountInUSD"`
+	AmountOutUSD          float64        `json:"amountOutUSD"`
+	SlippagePercentage    float64        `json:"slippagePercentage"`
+	PriceImpactPercentage float64        `json:"priceImpactPercentage"`
+	Fees                  SwapFees       `json:"fees"`
+	Route                 []SwapRouteHop `json:"route"`
+	ValidUntil            time.Time      `json:"validUntil"`
+}
+
+type SwapToken struct {
+	Address  string  `json:"address"`
+	Symbol   string  `json:"symbol"`
+	Name     string  `json:"name"`
+	Decimals int     `json:"decimals"`
+	Logo     string  `json:"logo"`
+	PriceUSD float64 `json:"priceUSD"`
+}
+
+type SwapFees struct {
+	PoolFeesPercentage float64 `json:"poolFeesPercentage"`
+	PoolFeesUSD        float64 `json:"poolFeesUSD"`
+	ProtocolFeesUSD    float64 `json:"protocolFeesUSD"`
+	GasEstimateUSD     float64 `json:"gasEstimateUSD"`
+	TotalFeesUSD       float64 `json:"totalFeesUSD"`
+}
+
+// SwapRouteHop is one pool traversed by a route; split routes list each pool with its share of the input.
+type SwapRouteHop struct {
+	PoolAddress     string  `json:"poolAddress"`
+	Exchange        string  `json:"exchange"`
+	TokenIn         string  `json:"tokenIn"`
+	TokenOut        string  `json:"tokenOut"`
+	AmountInRaw     string  `json:"amountInRaw"`
+	AmountOutRaw    string  `json:"amountOutRaw"`
+	FeePercentage   float64 `json:"feePercentage"`
+	SharePercentage float64 `json:"sharePercentage"`
+}
+
+// SwapTransactionData is an unsigned swap transaction. VM says which of EVM and Solana is set.
+type SwapTransactionData struct {
+	RequestID  string            `json:"requestId"`
+	ChainID    string            `json:"chainId"`
+	VM         SwapVM            `json:"vm"`
+	EVM        EVMTransaction    `json:"evm"`
+	Solana     SolanaTransaction `json:"solana"`
+	Quote      SwapQuoteData     `json:"quote"`
+	ValidUntil time.Time         `json:"validUntil"`
+}
+
+// SwapVM is the virtual machine a swap transaction targets.
+type SwapVM string
+
+const (
+	SwapVMEvm    SwapVM = "evm"
+	SwapVMSolana SwapVM = "solana"
+)
+
+// EVMTransaction is an unsigned EVM call. Quantities are decimal strings of wei.
+type EVMTransaction struct {
+	From                 string `json:"from"`
+	To                   string `json:"to"`
+	Data                 string `json:"data"`
+	Value                string `json:"value"`
+	GasLimit             string `json:"gasLimit"`
+	MaxFeePerGas         string `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+	Nonce                int64  `json:"nonce"`
+}
+
+// SolanaTransaction is an unsigned, base64 encoded versioned transaction.
+type SolanaTransaction struct {
+	Serialized           string `json:"serialized"`
+	RecentBlockhash      string `json:"recentBlockhash"`
+	LastValidBlockHeight int64  `json:"lastValidBlockHeight"`
+}
+
+type SwapSendData struct {
+	RequestID       string     `json:"requestId"`
+	ChainID         string     `json:"chainId"`
+	TransactionHash string     `json:"transactionHash"`
+	Status          SwapStatus `json:"status"`
+}
+
+// SwapStatus is the state of a broadcast swap transaction.
+type SwapStatus string
+
+const (
+	SwapStatusPending   SwapStatus = "pending"
+	SwapStatusConfirmed SwapStatus = "confirmed"
+	SwapStatusFailed    SwapStatus = "failed"
+)
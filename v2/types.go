@@ -0,0 +1,586 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import "time"
+
+// TokenSecurityData holds the security signals Mobula computes for a token contract.
+type TokenSecurityData struct {
+	Address                      string  `json:"address"`
+	ChainID                      string  `json:"chainId"`
+	ContractHoldingsPercentage   float64 `json:"contractHoldingsPercentage"`
+	ContractBalanceRaw           string  `json:"contractBalanceRaw"`
+	BurnedHoldingsPercentage     float64 `json:"burnedHoldingsPercentage"`
+	TotalBurnedBalanceRaw        string  `json:"totalBurnedBalanceRaw"`
+	BuyFeePercentage             float64 `json:"buyFeePercentage"`
+	SellFeePercentage            float64 `json:"sellFeePercentage"`
+	MaxWalletAmountRaw           any     `json:"maxWalletAmountRaw"`   // Raw amount, sent as a string or a number; null when unlimited
+	MaxSellAmountRaw             any     `json:"maxSellAmountRaw"`     // Raw amount, sent as a string or a number; null when unlimited
+	MaxBuyAmountRaw              any     `json:"maxBuyAmountRaw"`      // Raw amount, sent as a string or a number; null when unlimited
+	MaxTransferAmountRaw         any     `json:"maxTransferAmountRaw"` // Raw amount, sent as a string or a number; null when unlimited
+	IsLaunchpadToken             bool    `json:"isLaunchpadToken"`
+	Top10HoldingsPercentage      float64 `json:"top10HoldingsPercentage"`
+	Top50HoldingsPercentage      float64 `json:"top50HoldingsPercentage"`
+	Top100HoldingsPercentage     float64 `json:"top100HoldingsPercentage"`
+	Top200HoldingsPercentage     float64 `json:"top200HoldingsPercentage"`
+	IsMintable                   bool    `json:"isMintable"`
+	IsFreezable                  *bool   `json:"isFreezable"`
+	ProTraderVolume24HPercentage float64 `json:"proTraderVolume24hPercentage"`
+}
+
+// Token is the token model shared by the token, asset and market endpoints.
+type Token struct {
+	Address                        string    `json:"address"`
+	ChainID                        string    `json:"chainId"`
+	Symbol                         string    `json:"symbol"`
+	Name                           string    `json:"name"`
+	Decimals                       int       `json:"decimals"`
+	ID                             int       `json:"id"`
+	PriceUSD                       float64   `json:"priceUSD"`
+	PriceToken                     float64   `json:"priceToken"`
+	PriceTokenString               string    `json:"priceTokenString"`
+	ApproximateReserveUSD          float64   `json:"approximateReserveUSD"`
+	ApproximateReserveTokenRaw     string    `json:"approximateReserveTokenRaw"`
+	ApproximateReserveToken        float64   `json:"approximateReserveToken"`
+	TotalSupply                    float64   `json:"totalSupply"`
+	CirculatingSupply              float64   `json:"circulatingSupply"`
+	MarketCapUSD                   float64   `json:"marketCapUSD"`
+	MarketCapDilutedUSD            float64   `json:"marketCapDilutedUSD"`
+	Logo                           string    `json:"logo"`
+	Rank                           int       `json:"rank"`
+	Cexs                           []any     `json:"cexs"`
+	Exchange                       Exchange  `json:"exchange"`
+	Factory                        string    `json:"factory"`
+	Source                         string    `json:"source"`
+	LiquidityUSD                   float64   `json:"liquidityUSD"`
+	LiquidityMaxUSD                float64   `json:"liquidityMaxUSD"`
+	Bonded                         bool      `json:"bonded"`
+	BondingPercentage              float64   `json:"bondingPercentage"`
+	PoolAddress                    string    `json:"poolAddress"`
+	Blockchain                     string    `json:"blockchain"`
+	Type                           string    `json:"type"`
+	TokenType                      string    `json:"tokenType"`
+	Deployer                       string    `json:"deployer"`
+	BondedAt                       time.Time `json:"bondedAt"`
+	AthUSD                         float64   `json:"athUSD"`
+	AtlUSD                         float64   `json:"atlUSD"`
+	AthDate                        time.Time `json:"athDate"`
+	AtlDate                        time.Time `json:"atlDate"`
+	PriceChange1MinPercentage      float64   `json:"priceChange1minPercentage"`
+	PriceChange5MinPercentage      float64   `json:"priceChange5minPercentage"`
+	PriceChange1HPercentage        float64   `json:"priceChange1hPercentage"`
+	PriceChange4HPercentage        float64   `json:"priceChange4hPercentage"`
+	PriceChange6HPercentage        float64   `json:"priceChange6hPercentage"`
+	PriceChange12HPercentage       float64   `json:"priceChange12hPercentage"`
+	PriceChange24HPercentage       float64   `json:"priceChange24hPercentage"`
+	Volume1MinUSD                  float64   `json:"volume1minUSD"`
+	Volume5MinUSD                  float64   `json:"volume5minUSD"`
+	Volume15MinUSD                 float64   `json:"volume15minUSD"`
+	Volume1HUSD                    float64   `json:"volume1hUSD"`
+	Volume4HUSD                    float64   `json:"volume4hUSD"`
+	Volume6HUSD                    float64   `json:"volume6hUSD"`
+	Volume12HUSD                   float64   `json:"volume12hUSD"`
+	Volume24HUSD                   float64   `json:"volume24hUSD"`
+	VolumeBuy1MinUSD               float64   `json:"volumeBuy1minUSD"`
+	VolumeBuy5MinUSD               float64   `json:"volumeBuy5minUSD"`
+	VolumeBuy15MinUSD              float64   `json:"volumeBuy15minUSD"`
+	VolumeBuy1HUSD                 float64   `json:"volumeBuy1hUSD"`
+	VolumeBuy4HUSD                 float64   `json:"volumeBuy4hUSD"`
+	VolumeBuy6HUSD                 float64   `json:"volumeBuy6hUSD"`
+	VolumeBuy12HUSD                float64   `json:"volumeBuy12hUSD"`
+	VolumeBuy24HUSD                float64   `json:"volumeBuy24hUSD"`
+	VolumeSell1MinUSD              float64   `json:"volumeSell1minUSD"`
+	VolumeSell5MinUSD              float64   `json:"volumeSell5minUSD"`
+	VolumeSell15MinUSD             float64   `json:"volumeSell15minUSD"`
+	VolumeSell1HUSD                float64   `json:"volumeSell1hUSD"`
+	VolumeSell4HUSD                float64   `json:"volumeSell4hUSD"`
+	VolumeSell6HUSD                float64   `json:"volumeSell6hUSD"`
+	VolumeSell12HUSD               float64   `json:"volumeSell12hUSD"`
+	VolumeSell24HUSD               float64   `json:"volumeSell24hUSD"`
+	Trades1Min                     int       `json:"trades1min"`
+	Trades5Min                     int       `json:"trades5min"`
+	Trades15Min                    int       `json:"trades15min"`
+	Trades1H                       int       `json:"trades1h"`
+	Trades4H                       int       `json:"trades4h"`
+	Trades6H                       int       `json:"trades6h"`
+	Trades12H                      int       `json:"trades12h"`
+	Trades24H                      int       `json:"trades24h"`
+	Buys1Min                       int       `json:"buys1min"`
+	Buys5Min                       int       `json:"buys5min"`
+	Buys15Min                      int       `json:"buys15min"`
+	Buys1H                         int       `json:"buys1h"`
+	Buys4H                         int       `json:"buys4h"`
+	Buys6H                         int       `json:"buys6h"`
+	Buys12H                        int       `json:"buys12h"`
+	Buys24H                        int       `json:"buys24h"`
+	Sells1Min                      int       `json:"sells1min"`
+	Sells5Min                      int       `json:"sells5min"`
+	Sells15Min                     int       `json:"sells15min"`
+	Sells1H                        int       `json:"sells1h"`
+	Sells4H                        int       `json:"sells4h"`
+	Sells6H                        int       `json:"sells6h"`
+	Sells12H                       int       `json:"sells12h"`
+	Sells24H                       int       `json:"sells24h"`
+	Buyers1Min                     int       `json:"buyers1min"`
+	Buyers5Min                     int       `json:"buyers5min"`
+	Buyers15Min                    int       `json:"buyers15min"`
+	Buyers1H                       int       `json:"buyers1h"`
+	Buyers4H                       int       `json:"buyers4h"`
+	Buyers6H                       int       `json:"buyers6h"`
+	Buyers12H                      int       `json:"buyers12h"`
+	Buyers24H                      int       `json:"buyers24h"`
+	Sellers1Min                    int       `json:"sellers1min"`
+	Sellers5Min                    int       `json:"sellers5min"`
+	Sellers15Min                   int       `json:"sellers15min"`
+	Sellers1H                      int       `json:"sellers1h"`
+	Sellers4H                      int       `json:"sellers4h"`
+	Sellers6H                      int       `json:"sellers6h"`
+	Sellers12H                     int       `json:"sellers12h"`
+	Sellers24H                     int       `json:"sellers24h"`
+	Traders1Min                    int       `json:"traders1min"`
+	Traders5Min                    int       `json:"traders5min"`
+	Traders15Min                   int       `json:"traders15min"`
+	Traders1H                      int       `json:"traders1h"`
+	Traders4H                      int       `json:"traders4h"`
+	Traders6H                      int       `json:"traders6h"`
+	Traders12H                     int       `json:"traders12h"`
+	Traders24H                     int       `json:"traders24h"`
+	FeesPaid1MinUSD                float64   `json:"feesPaid1minUSD"`
+	FeesPaid5MinUSD                float64   `json:"feesPaid5minUSD"`
+	FeesPaid15MinUSD               float64   `json:"feesPaid15minUSD"`
+	FeesPaid1HUSD                  float64   `json:"feesPaid1hUSD"`
+	FeesPaid4HUSD                  float64   `json:"feesPaid4hUSD"`
+	FeesPaid6HUSD                  float64   `json:"feesPaid6hUSD"`
+	FeesPaid12HUSD                 float64   `json:"feesPaid12hUSD"`
+	FeesPaid24HUSD                 float64   `json:"feesPaid24hUSD"`
+	TotalFeesPaidUSD               float64   `json:"totalFeesPaidUSD"`
+	TotalFeesPaidNativeRaw         string    `json:"totalFeesPaidNativeRaw"`
+	OrganicTrades1Min              int       `json:"organicTrades1min"`
+	OrganicTrades5Min              int       `json:"organicTrades5min"`
+	OrganicTrades15Min             int       `json:"organicTrades15min"`
+	OrganicTrades1H                int       `json:"organicTrades1h"`
+	OrganicTrades4H                int       `json:"organicTrades4h"`
+	OrganicTrades6H                int       `json:"organicTrades6h"`
+	OrganicTrades12H               int       `json:"organicTrades12h"`
+	OrganicTrades24H               int       `json:"organicTrades24h"`
+	OrganicTraders1Min             int       `json:"organicTraders1min"`
+	OrganicTraders5Min             int       `json:"organicTraders5min"`
+	OrganicTraders15Min            int       `json:"organicTraders15min"`
+	OrganicTraders1H               int       `json:"organicTraders1h"`
+	OrganicTraders4H               int       `json:"organicTraders4h"`
+	OrganicTraders6H               int       `json:"organicTraders6h"`
+	OrganicTraders12H              int       `json:"organicTraders12h"`
+	OrganicTraders24H              int       `json:"organicTraders24h"`
+	OrganicVolume1MinUSD           float64   `json:"organicVolume1minUSD"`
+	OrganicVolume5MinUSD           float64   `json:"organicVolume5minUSD"`
+	OrganicVolume15MinUSD          float64   `json:"organicVolume15minUSD"`
+	OrganicVolume1HUSD             float64   `json:"organicVolume1hUSD"`
+	OrganicVolume4HUSD             float64   `json:"organicVolume4hUSD"`
+	OrganicVolume6HUSD             float64   `json:"organicVolume6hUSD"`
+	OrganicVolume12HUSD            float64   `json:"organicVolume12hUSD"`
+	OrganicVolume24HUSD            float64   `json:"organicVolume24hUSD"`
+	OrganicVolumeBuy1MinUSD        float64   `json:"organicVolumeBuy1minUSD"`
+	OrganicVolumeBuy5MinUSD        float64   `json:"organicVolumeBuy5minUSD"`
+	OrganicVolumeBuy15MinUSD       float64   `json:"organicVolumeBuy15minUSD"`
+	OrganicVolumeBuy1HUSD          float64   `json:"organicVolumeBuy1hUSD"`
+	OrganicVolumeBuy4HUSD          float64   `json:"organicVolumeBuy4hUSD"`
+	OrganicVolumeBuy6HUSD          float64   `json:"organicVolumeBuy6hUSD"`
+	OrganicVolumeBuy12HUSD         float64   `json:"organicVolumeBuy12hUSD"`
+	OrganicVolumeBuy24HUSD         float64   `json:"organicVolumeBuy24hUSD"`
+	OrganicVolumeSell1MinUSD       float64   `json:"organicVolumeSell1minUSD"`
+	OrganicVolumeSell5MinUSD       float64   `json:"organicVolumeSell5minUSD"`
+	OrganicVolumeSell15MinUSD      float64   `json:"organicVolumeSell15minUSD"`
+	OrganicVolumeSell1HUSD         float64   `json:"organicVolumeSell1hUSD"`
+	OrganicVolumeSell4HUSD         float64   `json:"organicVolumeSell4hUSD"`
+	OrganicVolumeSell6HUSD         float64   `json:"organicVolumeSell6hUSD"`
+	OrganicVolumeSell12HUSD        float64   `json:"organicVolumeSell12hUSD"`
+	OrganicVolumeSell24HUSD        float64   `json:"organicVolumeSell24hUSD"`
+	OrganicBuys1Min                int       `json:"organicBuys1min"`
+	OrganicBuys5Min                int       `json:"organicBuys5min"`
+	OrganicBuys15Min               int       `json:"organicBuys15min"`
+	OrganicBuys1H                  int       `json:"organicBuys1h"`
+	OrganicBuys4H                  int       `json:"organicBuys4h"`
+	OrganicBuys6H                  int       `json:"organicBuys6h"`
+	OrganicBuys12H                 int       `json:"organicBuys12h"`
+	OrganicBuys24H                 int       `json:"organicBuys24h"`
+	OrganicSells1Min               int       `json:"organicSells1min"`
+	OrganicSells5Min               int       `json:"organicSells5min"`
+	OrganicSells15Min              int       `json:"organicSells15min"`
+	OrganicSells1H                 int       `json:"organicSells1h"`
+	OrganicSells4H                 int       `json:"organicSells4h"`
+	OrganicSells6H                 int       `json:"organicSells6h"`
+	OrganicSells12H                int       `json:"organicSells12h"`
+	OrganicSells24H                int       `json:"organicSells24h"`
+	OrganicBuyers1Min              int       `json:"organicBuyers1min"`
+	OrganicBuyers5Min              int       `json:"organicBuyers5min"`
+	OrganicBuyers15Min             int       `json:"organicBuyers15min"`
+	OrganicBuyers1H                int       `json:"organicBuyers1h"`
+	OrganicBuyers4H                int       `json:"organicBuyers4h"`
+	OrganicBuyers6H                int       `json:"organicBuyers6h"`
+	OrganicBuyers12H               int       `json:"organicBuyers12h"`
+	OrganicBuyers24H               int       `json:"organicBuyers24h"`
+	OrganicSellers1Min             int       `json:"organicSellers1min"`
+	OrganicSellers5Min             int       `json:"organicSellers5min"`
+	OrganicSellers15Min            int       `json:"organicSellers15min"`
+	OrganicSellers1H               int       `json:"organicSellers1h"`
+	OrganicSellers4H               int       `json:"organicSellers4h"`
+	OrganicSellers6H               int       `json:"organicSellers6h"`
+	OrganicSellers12H              int       `json:"organicSellers12h"`
+	OrganicSellers24H              int       `json:"organicSellers24h"`
+	CreatedAt                      time.Time `json:"createdAt"`
+	LatestTradeDate                time.Time `json:"latestTradeDate"`
+	HoldersCount                   int       `json:"holdersCount"`
+	Description                    string    `json:"description"`
+	Socials                        Socials   `json:"socials"`
+	Security                       Security  `json:"security"`
+	TwitterReusesCount             int       `json:"twitterReusesCount"`
+	TwitterRenameCount             int       `json:"twitterRenameCount"`
+	TwitterRenameHistory           []any     `json:"twitterRenameHistory"`
+	DeployerMigrationsCount        int       `json:"deployerMigrationsCount"`
+	DeployerTokensCount            int       `json:"deployerTokensCount"`
+	DexscreenerListed              bool      `json:"dexscreenerListed"`
+	DexscreenerHeader              string    `json:"dexscreenerHeader"`
+	DexscreenerAdPaid              bool      `json:"dexscreenerAdPaid"`
+	DexscreenerAdPaidDate          time.Time `json:"dexscreenerAdPaidDate"`
+	DexscreenerSocialPaid          bool      `json:"dexscreenerSocialPaid"`
+	DexscreenerSocialPaidDate      time.Time `json:"dexscreenerSocialPaidDate"`
+	LiveStatus                     any       `json:"liveStatus"`
+	LiveThumbnail                  string    `json:"liveThumbnail"`
+	LivestreamTitle                string    `json:"livestreamTitle"`
+	LiveReplyCount                 int       `json:"liveReplyCount"`
+	DexscreenerBoosted             bool      `json:"dexscreenerBoosted"`
+	DexscreenerBoostedDate         time.Time `json:"dexscreenerBoostedDate"`
+	DexscreenerBoostedAmount       float64   `json:"dexscreenerBoostedAmount"`
+	TrendingScore1Min              float64   `json:"trendingScore1min"`
+	TrendingScore5Min              float64   `json:"trendingScore5min"`
+	TrendingScore15Min             float64   `json:"trendingScore15min"`
+	TrendingScore1H                float64   `json:"trendingScore1h"`
+	TrendingScore4H                float64   `json:"trendingScore4h"`
+	TrendingScore6H                float64   `json:"trendingScore6h"`
+	TrendingScore12H               float64   `json:"trendingScore12h"`
+	TrendingScore24H               float64   `json:"trendingScore24h"`
+	Top10HoldingsPercentage        float64   `json:"top10HoldingsPercentage"`
+	Top50HoldingsPercentage        float64   `json:"top50HoldingsPercentage"`
+	Top100HoldingsPercentage       float64   `json:"top100HoldingsPercentage"`
+	Top200HoldingsPercentage       float64   `json:"top200HoldingsPercentage"`
+	DevHoldingsPercentage          float64   `json:"devHoldingsPercentage"`
+	InsidersHoldingsPercentage     float64   `json:"insidersHoldingsPercentage"`
+	BundlersHoldingsPercentage     float64   `json:"bundlersHoldingsPercentage"`
+	SnipersHoldingsPercentage      float64   `json:"snipersHoldingsPercentage"`
+	ProTradersHoldingsPercentage   float64   `json:"proTradersHoldingsPercentage"`
+	FreshTradersHoldingsPercentage float64   `json:"freshTradersHoldingsPercentage"`
+	SmartTradersHoldingsPercentage float64   `json:"smartTradersHoldingsPercentage"`
+	InsidersCount                  int       `json:"insidersCount"`
+	BundlersCount                  int       `json:"bundlersCount"`
+	SnipersCount                   int       `json:"snipersCount"`
+	FreshTradersCount              int       `json:"freshTradersCount"`
+	ProTradersCount                int       `json:"proTradersCount"`
+	SmartTradersCount              int       `json:"smartTradersCount"`
+	FreshTradersBuys               int       `json:"freshTradersBuys"`
+	ProTradersBuys                 int       `json:"proTradersBuys"`
+	SmartTradersBuys               int       `json:"smartTradersBuys"`
+	SourceFactory                  string    `json:"sourceFactory"`
+	BondingCurveAddress            string    `json:"bondingCurveAddress"`
+	PreBondingFactory              string    `json:"preBondingFactory"`
+}
+
+// Exchange identifies the DEX or launchpad a token or market trades on.
+type Exchange struct {
+	Name string `json:"name"`
+	Logo string `json:"logo"`
+}
+
+// Socials holds the social links attached to a token or market.
+type Socials struct {
+	Twitter  string         `json:"twitter"`
+	Website  string         `json:"website"`
+	Telegram string         `json:"telegram"`
+	Others   map[string]any `json:"others"`
+}
+
+// Security holds the contract checks attached to a token or market.
+type Security struct {
+	BuyTax           string `json:"buyTax"`
+	SellTax          string `json:"sellTax"`
+	TransferPausable bool   `json:"transferPausable"`
+	Top10Holders     string `json:"top10Holders"`
+	IsBlacklisted    bool   `json:"isBlacklisted"`
+	BalanceMutable   bool   `json:"balanceMutable"`
+	BurnRate         string `json:"burnRate"`
+	IsHoneypot       bool   `json:"isHoneypot"`
+	IsNotOpenSource  bool   `json:"isNotOpenSource"`
+	Renounced        bool   `json:"renounced"`
+	Locked           string `json:"locked"`
+	IsWhitelisted    bool   `json:"isWhitelisted"`
+	IsMintable       bool   `json:"isMintable"`
+	ModifyableTax    bool   `json:"modifyableTax"`
+	SelfDestruct     bool   `json:"selfDestruct"`
+	NoMintAuthority  bool   `json:"noMintAuthority"`
+}
+
+// Asset is the chain-independent view of an asset.
+type Asset struct {
+	ID                  int          `json:"id"`
+	Name                string       `json:"name"`
+	Symbol              string       `json:"symbol"`
+	Logo                string       `json:"logo"`
+	Description         string       `json:"description"`
+	Rank                int          `json:"rank"`
+	NativeChainID       any          `json:"nativeChainId"`
+	PriceUSD            float64      `json:"priceUSD"`
+	TotalSupply         float64      `json:"totalSupply"`
+	CirculatingSupply   float64      `json:"circulatingSupply"`
+	MarketCapUSD        float64      `json:"marketCapUSD"`
+	MarketCapDilutedUSD float64      `json:"marketCapDilutedUSD"`
+	AthPriceDate        time.Time    `json:"athPriceDate"`
+	AthPriceUSD         float64      `json:"athPriceUSD"`
+	AtlPriceDate        time.Time    `json:"atlPriceDate"`
+	AtlPriceUSD         float64      `json:"atlPriceUSD"`
+	IsStablecoin        bool         `json:"isStablecoin"`
+	CreatedAt           time.Time    `json:"createdAt"`
+	ListedAt            time.Time    `json:"listedAt"`
+	Socials             AssetSocials `json:"socials"`
+}
+
+// AssetSocials holds the social links attached to an asset.
+type AssetSocials struct {
+	Audit   string `json:"audit"`
+	Github  string `json:"github"`
+	Twitter string `json:"twitter"`
+	Website string `json:"website"`
+	Kyc     string `json:"kyc"`
+	Chat    string `json:"chat"`
+	Discord string `json:"discord"`
+}
+
+// AssetDetailsData is an asset together with its deployments on every chain.
+type AssetDetailsData struct {
+	Asset       Asset   `json:"asset"`
+	Tokens      []Token `json:"tokens"`
+	TokensCount int     `json:"tokensCount"`
+}
+
+// Market is a trading pool between a base and a quote token.
+type Market struct {
+	Base                           Token           `json:"base"`
+	Quote                          Token           `json:"quote"`
+	LiquidityUSD                   float64         `json:"liquidityUSD"`
+	LatestTradeDate                time.Time       `json:"latestTradeDate"`
+	Blockchain                     string          `json:"blockchain"`
+	Address                        string          `json:"address"`
+	CreatedAt                      time.Time       `json:"createdAt"`
+	Type                           string          `json:"type"`
+	Exchange                       Exchange        `json:"exchange"`
+	Factory                        string          `json:"factory"`
+	PriceUSD                       float64         `json:"priceUSD"`
+	PriceToken                     float64         `json:"priceToken"`
+	PriceTokenString               string          `json:"priceTokenString"`
+	BaseToken                      string          `json:"baseToken"`
+	QuoteToken                     string          `json:"quoteToken"`
+	Bonded                         bool            `json:"bonded"`
+	BondingPercentage              float64         `json:"bondingPercentage"`
+	PreBondingPoolAddress          string          `json:"preBondingPoolAddress"`
+	SourceFactory                  string          `json:"sourceFactory"`
+	TotalFeesPaidUSD               float64         `json:"totalFeesPaidUSD"`
+	TotalFeesPaidNativeRaw         string          `json:"totalFeesPaidNativeRaw"`
+	PriceChange1MinPercentage      float64         `json:"priceChange1minPercentage"`
+	PriceChange5MinPercentage      float64         `json:"priceChange5minPercentage"`
+	PriceChange1HPercentage        float64         `json:"priceChange1hPercentage"`
+	PriceChange4HPercentage        float64         `json:"priceChange4hPercentage"`
+	PriceChange6HPercentage        float64         `json:"priceChange6hPercentage"`
+	PriceChange12HPercentage       float64         `json:"priceChange12hPercentage"`
+	PriceChange24HPercentage       float64         `json:"priceChange24hPercentage"`
+	Volume1MinUSD                  float64         `json:"volume1minUSD"`
+	Volume5MinUSD                  float64         `json:"volume5minUSD"`
+	Volume15MinUSD                 float64         `json:"volume15minUSD"`
+	Volume1HUSD                    float64         `json:"volume1hUSD"`
+	Volume4HUSD                    float64         `json:"volume4hUSD"`
+	Volume6HUSD                    float64         `json:"volume6hUSD"`
+	Volume12HUSD                   float64         `json:"volume12hUSD"`
+	Volume24HUSD                   float64         `json:"volume24hUSD"`
+	VolumeBuy1MinUSD               float64         `json:"volumeBuy1minUSD"`
+	VolumeBuy5MinUSD               float64         `json:"volumeBuy5minUSD"`
+	VolumeBuy15MinUSD              float64         `json:"volumeBuy15minUSD"`
+	VolumeBuy1HUSD                 float64         `json:"volumeBuy1hUSD"`
+	VolumeBuy4HUSD                 float64         `json:"volumeBuy4hUSD"`
+	VolumeBuy6HUSD                 float64         `json:"volumeBuy6hUSD"`
+	VolumeBuy12HUSD                float64         `json:"volumeBuy12hUSD"`
+	VolumeBuy24HUSD                float64         `json:"volumeBuy24hUSD"`
+	VolumeSell1MinUSD              float64         `json:"volumeSell1minUSD"`
+	VolumeSell5MinUSD              float64         `json:"volumeSell5minUSD"`
+	VolumeSell15MinUSD             float64         `json:"volumeSell15minUSD"`
+	VolumeSell1HUSD                float64         `json:"volumeSell1hUSD"`
+	VolumeSell4HUSD                float64         `json:"volumeSell4hUSD"`
+	VolumeSell6HUSD                float64         `json:"volumeSell6hUSD"`
+	VolumeSell12HUSD               float64         `json:"volumeSell12hUSD"`
+	VolumeSell24HUSD               float64         `json:"volumeSell24hUSD"`
+	Trades1Min                     int             `json:"trades1min"`
+	Trades5Min                     int             `json:"trades5min"`
+	Trades15Min                    int             `json:"trades15min"`
+	Trades1H                       int             `json:"trades1h"`
+	Trades4H                       int             `json:"trades4h"`
+	Trades6H                       int             `json:"trades6h"`
+	Trades12H                      int             `json:"trades12h"`
+	Trades24H                      int             `json:"trades24h"`
+	Buys1Min                       int             `json:"buys1min"`
+	Buys5Min                       int             `json:"buys5min"`
+	Buys15Min                      int             `json:"buys15min"`
+	Buys1H                         int             `json:"buys1h"`
+	Buys4H                         int             `json:"buys4h"`
+	Buys6H                         int             `json:"buys6h"`
+	Buys12H                        int             `json:"buys12h"`
+	Buys24H                        int             `json:"buys24h"`
+	Sells1Min                      int             `json:"sells1min"`
+	Sells5Min                      int             `json:"sells5min"`
+	Sells15Min                     int             `json:"sells15min"`
+	Sells1H                        int             `json:"sells1h"`
+	Sells4H                        int             `json:"sells4h"`
+	Sells6H                        int             `json:"sells6h"`
+	Sells12H                       int             `json:"sells12h"`
+	Sells24H                       int             `json:"sells24h"`
+	Buyers1Min                     int             `json:"buyers1min"`
+	Buyers5Min                     int             `json:"buyers5min"`
+	Buyers15Min                    int             `json:"buyers15min"`
+	Buyers1H                       int             `json:"buyers1h"`
+	Buyers4H                       int             `json:"buyers4h"`
+	Buyers6H                       int             `json:"buyers6h"`
+	Buyers12H                      int             `json:"buyers12h"`
+	Buyers24H                      int             `json:"buyers24h"`
+	Sellers1Min                    int             `json:"sellers1min"`
+	Sellers5Min                    int             `json:"sellers5min"`
+	Sellers15Min                   int             `json:"sellers15min"`
+	Sellers1H                      int             `json:"sellers1h"`
+	Sellers4H                      int             `json:"sellers4h"`
+	Sellers6H                      int             `json:"sellers6h"`
+	Sellers12H                     int             `json:"sellers12h"`
+	Sellers24H                     int             `json:"sellers24h"`
+	Traders1Min                    int             `json:"traders1min"`
+	Traders5Min                    int             `json:"traders5min"`
+	Traders15Min                   int             `json:"traders15min"`
+	Traders1H                      int             `json:"traders1h"`
+	Traders4H                      int             `json:"traders4h"`
+	Traders6H                      int             `json:"traders6h"`
+	Traders12H                     int             `json:"traders12h"`
+	Traders24H                     int             `json:"traders24h"`
+	FeesPaid1MinUSD                float64         `json:"feesPaid1minUSD"`
+	FeesPaid5MinUSD                float64         `json:"feesPaid5minUSD"`
+	FeesPaid15MinUSD               float64         `json:"feesPaid15minUSD"`
+	FeesPaid1HUSD                  float64         `json:"feesPaid1hUSD"`
+	FeesPaid4HUSD                  float64         `json:"feesPaid4hUSD"`
+	FeesPaid6HUSD                  float64         `json:"feesPaid6hUSD"`
+	FeesPaid12HUSD                 float64         `json:"feesPaid12hUSD"`
+	FeesPaid24HUSD                 float64         `json:"feesPaid24hUSD"`
+	HoldersCount                   int             `json:"holdersCount"`
+	Source                         string          `json:"source"`
+	Deployer                       string          `json:"deployer"`
+	TokenSymbol                    string          `json:"tokenSymbol"`
+	TokenName                      string          `json:"tokenName"`
+	DexscreenerListed              bool            `json:"dexscreenerListed"`
+	DeployerMigrations             int             `json:"deployerMigrations"`
+	Socials                        Socials         `json:"socials"`
+	Description                    string          `json:"description"`
+	Security                       Security        `json:"security"`
+	TwitterReusesCount             int             `json:"twitterReusesCount"`
+	TwitterRenameCount             int             `json:"twitterRenameCount"`
+	TwitterRenameHistory           []any           `json:"twitterRenameHistory"`
+	ExtraData                      MarketExtraData `json:"extraData"`
+	Top10HoldingsPercentage        float64         `json:"top10HoldingsPercentage"`
+	Top50HoldingsPercentage        float64         `json:"top50HoldingsPercentage"`
+	Top100HoldingsPercentage       float64         `json:"top100HoldingsPercentage"`
+	Top200HoldingsPercentage       float64         `json:"top200HoldingsPercentage"`
+	DevHoldingsPercentage          float64         `json:"devHoldingsPercentage"`
+	InsidersHoldingsPercentage     float64         `json:"insidersHoldingsPercentage"`
+	BundlersHoldingsPercentage     float64         `json:"bundlersHoldingsPercentage"`
+	SnipersHoldingsPercentage      float64         `json:"snipersHoldingsPercentage"`
+	ProTradersHoldingsPercentage   float64         `json:"proTradersHoldingsPercentage"`
+	FreshTradersHoldingsPercentage float64         `json:"freshTradersHoldingsPercentage"`
+	InsidersCount                  int             `json:"insidersCount"`
+	BundlersCount                  int             `json:"bundlersCount"`
+	SnipersCount                   int             `json:"snipersCount"`
+	FreshTradersCount              int             `json:"freshTradersCount"`
+	ProTradersCount                int             `json:"proTradersCount"`
+}
+
+// MarketExtraData holds the protocol specific fields of a market.
+type MarketExtraData struct {
+	Account0         string  `json:"account0"`
+	Account1         string  `json:"account1"`
+	OpenOrders       string  `json:"openOrders"`
+	TargetOrders     string  `json:"targetOrders"`
+	MarketProgramID  string  `json:"marketProgramId"`
+	MarketBids       string  `json:"marketBids"`
+	MarketAsks       string  `json:"marketAsks"`
+	MarketEventQueue string  `json:"marketEventQueue"`
+	MarketBaseVault  string  `json:"marketBaseVault"`
+	MarketQuoteVault string  `json:"marketQuoteVault"`
+	VaultSignerNonce string  `json:"vaultSignerNonce"`
+	SqrtPriceX96     string  `json:"sqrtPriceX96"`
+	TickSpacing      int     `json:"tickSpacing"`
+	PoolKey          PoolKey `json:"poolKey"`
+	SqrtPriceX64     string  `json:"sqrtPriceX64"`
+	Tick             int     `json:"tick"`
+}
+
+// PoolKey identifies a Uniswap v4 style pool.
+type PoolKey struct {
+	Currency0   string `json:"currency0"`
+	Currency1   string `json:"currency1"`
+	Fee         int    `json:"fee"`
+	TickSpacing int    `json:"tickSpacing"`
+	Hooks       string `json:"hooks"`
+}
+
+// SortOrder is the direction of a sorted listing.
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// OHLCVPeriod is the duration of one candle.
+type OHLCVPeriod string
+
+const (
+	OHLCVPeriod1Min  OHLCVPeriod = "1min"
+	OHLCVPeriod5Min  OHLCVPeriod = "5min"
+	OHLCVPeriod15Min OHLCVPeriod = "15min"
+	OHLCVPeriod1H    OHLCVPeriod = "1h"
+	OHLCVPeriod4H    OHLCVPeriod = "4h"
+	OHLCVPeriod1D    OHLCVPeriod = "1d"
+)
+
+// OHLCVCandle is the price of a token over one period.
+type OHLCVCandle struct {
+	Time   int64   `json:"time"` // Candle open time in Unix milliseconds
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"` // Volume in USD
+}
+
+// TradeType is the side of a trade from the token's point of view.
+type TradeType string
+
+const (
+	TradeTypeBuy  TradeType = "buy"
+	TradeTypeSell TradeType = "sell"
+)
+
+// TokenTrade is one swap of a token.
+type TokenTrade struct {
+	ID                       string    `json:"id"`
+	Type                     TradeType `json:"type"`
+	Date                     int64     `json:"date"`            // Block time in Unix milliseconds
+	BaseTokenAmount          float64   `json:"baseTokenAmount"` // Amount of the token traded
+	BaseTokenAmountUSD       float64   `json:"baseTokenAmountUSD"`
+	BaseTokenPriceUSD        float64   `json:"baseTokenPriceUSD"`
+	QuoteTokenAmount         float64   `json:"quoteTokenAmount"`
+	QuoteTokenAmountUSD      float64   `json:"quoteTokenAmountUSD"`
+	MarketAddress            string    `json:"marketAddress"` // Pool the trade went through
+	SwapSenderAddress        string    `json:"swapSenderAddress"`
+	TransactionSenderAddress string    `json:"transactionSenderAddress"`
+	TransactionHash          string    `json:"transactionHash"`
+	Blockchain               string    `json:"blockchain"`
+}
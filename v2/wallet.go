@@ -0,0 +1,117 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Wallet Data
+
+	// WalletPortfolio https://docs.mobula.io/rest-api-reference/endpoint/wallet-portfolio
+	WalletPortfolio = "/api/2/wallet/portfolio"
+	// WalletHistory https://docs.mobula.io/rest-api-reference/endpoint/wallet-history
+	WalletHistory = "/api/2/wallet/history"
+	// WalletLabels https://docs.mobula.io/rest-api-reference/endpoint/wallet-labels
+	WalletLabels = "/api/2/wallet/labels"
+	// WalletNFTs https://docs.mobula.io/rest-api-reference/endpoint/wallet-nfts
+	WalletNFTs = "/api/2/wallet/nfts"
+	// NFTCollection https://docs.mobula.io/rest-api-reference/endpoint/nft-collection
+	NFTCollection = "/api/2/nft/collection"
+)
+
+// GetWalletPortfolio retrieves the fungible token positions of a wallet
+func GetWalletPortfolio(ctx context.Context, client HTTPClient, req *WalletPortfolioRequest) (*WalletPortfolioResponse, error) {
+	params := url.Values{}
+	params.Set("wallet", req.Wallet)
+	if len(req.Blockchains) > 0 {
+		params.Set("blockchains", strings.Join(req.Blockchains, ","))
+	}
+
+	var resp WalletPortfolioResponse
+	if err := client.Get(ctx, WalletPortfolio, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWalletHistory retrieves the net worth and PnL of a wallet over time
+func GetWalletHistory(ctx context.Context, client HTTPClient, req *WalletHistoryRequest) (*WalletHistoryResponse, error) {
+	params := url.Values{}
+	params.Set("wallet", req.Wallet)
+	if len(req.Blockchains) > 0 {
+		params.Set("blockchains", strings.Join(req.Blockchains, ","))
+	}
+	if req.From != 0 {
+		params.Set("from", strconv.FormatInt(req.From, 10))
+	}
+	if req.To != 0 {
+		params.Set("to", strconv.FormatInt(req.To, 10))
+	}
+
+	var resp WalletHistoryResponse
+	if err := client.Get(ctx, WalletHistory, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWalletLabels retrieves the labels and trader classification of wallets
+func GetWalletLabels(ctx context.Context, client HTTPClient, req *WalletLabelsRequest) (*WalletLabelsResponse, error) {
+	params := url.Values{}
+	params.Set("wallets", strings.Join(req.Wallets, ","))
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+
+	var resp WalletLabelsResponse
+	if err := client.Get(ctx, WalletLabels, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetWalletNFTs retrieves the NFTs held by a wallet, one page at a time
+func GetWalletNFTs(ctx context.Context, client HTTPClient, req *WalletNFTsRequest) (*WalletNFTsResponse, error) {
+	params := url.Values{}
+	params.Set("wallet", req.Wallet)
+	if len(req.Blockchains) > 0 {
+		params.Set("blockchains", strings.Join(req.Blockchains, ","))
+	}
+	if req.Cursor != "" {
+		params.Set("cursor", req.Cursor)
+	}
+	if req.Limit != 0 {
+		params.Set("limit", strconv.Itoa(req.Limit))
+	}
+
+	var resp WalletNFTsResponse
+	if err := client.Get(ctx, WalletNFTs, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetNFTCollection retrieves the metadata and market figures of an NFT collection
+func GetNFTCollection(ctx context.Context, client HTTPClient, req *NFTCollectionRequest) (*NFTCollectionResponse, error) {
+	params := url.Values{}
+	params.Set("address", req.Address)
+	if req.Blockchain != "" {
+		params.Set("blockchain", req.Blockchain)
+	}
+
+	var resp NFTCollectionResponse
+	if err := client.Get(ctx, NFTCollection, params, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package v2
+
+import "time"
+
+// ========================
+// Wallet Portfolio API Types
+// ========================
+
+type WalletPortfolioRequest struct {
+	Wallet      string   `json:"wallet"`                // Wallet address (required)
+	Blockchains []string `json:"blockchains,omitempty"` // Blockchains to include (optional, default: all)
+}
+type WalletPortfolioResponse struct {
+	Data WalletPortfolioData `json:"data"`
+}
+
+// ========================
+// Wallet History API Types
+// ========================
+
+type WalletHistoryRequest struct {
+	Wallet      string   `json:"wallet"`                // Wallet address (required)
+	Blockchains []string `json:"blockchains,omitempty"` // Blockchains to include, aggregated into one series (optional, default: all)
+	From        int64    `json:"from,omitempty"`        // Start of the range in Unix milliseconds (optional)
+	To          int64    `json:"to,omitempty"`          // End of the range in Unix milliseconds (optional)
+}
+type WalletHistoryResponse struct {
+	Data WalletHistoryData `json:"data"`
+}
+
+// ========================
+// Wallet Labels API Types
+// ========================
+
+type WalletLabelsRequest struct {
+	Wallets    []string `json:"wallets"`              // Wallet addresses (required, max: 100)
+	Blockchain string   `json:"blockchain,omitempty"` // Blockchain name (optional)
+}
+type WalletLabelsResponse struct {
+	Data []WalletLabelsData `json:"data"`
+}
+
+// ========================
+// Wallet NFTs API Types
+// ========================
+
+type WalletNFTsRequest struct {
+	Wallet      string   `json:"wallet"`                // Wallet address (required)
+	Blockchains []string `json:"blockchains,omitempty"` // Blockchains to include (optional, default: all)
+	Cursor      string   `json:"cursor,omitempty"`      // Cursor returned by the previous page (optional)
+	Limit       int      `json:"limit,omitempty"`       // Max number of NFTs per page (optional, max: 100)
+}
+type WalletNFTsResponse struct {
+	Data       []NFT  `json:"data"`
+	NextCursor string `json:"nextCursor"` // Empty on the last page
+}
+
+// ========================
+// NFT Collection API Types
+// ========================
+
+type NFTCollectionRequest struct {
+	Address    string `json:"address"`              // Collection contract address (required)
+	Blockchain string `json:"blockchain,omitempty"` // Blockchain name (optional)
+}
+type NFTCollectionResponse struct {
+	Data NFTCollectionData `json:"data"`
+}
+
+type WalletHistoryData struct {
+	Wallet           string               `json:"wallet"`
+	Blockchains      []string             `json:"blockchains"`
+	BalanceUSD       float64              `json:"balanceUSD"`
+	RealizedPnlUSD   float64              `json:"realizedPnlUSD"`
+	UnrealizedPnlUSD float64              `json:"unrealizedPnlUSD"`
+	BalanceHistory   []WalletHistoryPoint `json:"balanceHistory"`
+}
+
+// WalletHistoryPoint is the net worth and PnL of a wallet at one time.
+type WalletHistoryPoint struct {
+	Time             int64   `json:"time"` // Unix milliseconds
+	BalanceUSD       float64 `json:"balanceUSD"`
+	RealizedPnlUSD   float64 `json:"realizedPnlUSD"`   // Cumulative realized PnL
+	UnrealizedPnlUSD float64 `json:"unrealizedPnlUSD"` // Unrealized PnL of the holdings at Time
+}
+
+// WalletLabelsData is the classification of one wallet.
+type WalletLabelsData struct {
+	Wallet     string        `json:"wallet"`
+	Labels     []WalletLabel `json:"labels"`
+	EntityName string        `json:"entityName"` // Owner of the wallet when known, e.g. an exchange
+	FundedBy   string        `json:"fundedBy"`   // Wallet that first funded this one
+}
+
+// WalletLabel classifies the behaviour or owner of a wallet.
+type WalletLabel string
+
+const (
+	WalletLabelSniper        WalletLabel = "sniper"
+	WalletLabelInsider       WalletLabel = "insider"
+	WalletLabelBundler       WalletLabel = "bundler"
+	WalletLabelDev           WalletLabel = "dev"
+	WalletLabelSmartTrader   WalletLabel = "smart-trader"
+	WalletLabelProTrader     WalletLabel = "pro-trader"
+	WalletLabelFresh         WalletLabel = "fresh"
+	WalletLabelBot           WalletLabel = "bot"
+	WalletLabelWhale         WalletLabel = "whale"
+	WalletLabelKol           WalletLabel = "kol"
+	WalletLabelCex           WalletLabel = "cex"
+	WalletLabelLiquidityPool WalletLabel = "liquidity-pool"
+)
+
+// NFT is one non-fungible token held by a wallet.
+type NFT struct {
+	Blockchain        string         `json:"blockchain"`
+	CollectionAddress string         `json:"collectionAddress"`
+	CollectionName    string         `json:"collectionName"`
+	TokenID           string         `json:"tokenId"`
+	Standard          NFTStandard    `json:"standard"`
+	Amount            string         `json:"amount"` // Units held; above 1 only for ERC-1155
+	MetadataURI       string         `json:"metadataUri"`
+	Name              string         `json:"name"`
+	Description       string         `json:"description"`
+	Image             string         `json:"image"` // Resolved image URL, when the metadata was fetched
+	Attributes        []NFTAttribute `json:"attributes"`
+}
+
+// NFTStandard is the token standard of an NFT.
+type NFTStandard string
+
+const (
+	NFTStandardErc721   NFTStandard = "erc721"
+	NFTStandardErc1155  NFTStandard = "erc1155"
+	NFTStandardMetaplex NFTStandard = "metaplex"
+)
+
+type NFTAttribute struct {
+	TraitType string `json:"traitType"`
+	Value     any    `json:"value"` // String or number, as found in the metadata
+}
+
+// NFTCollectionData describes an NFT collection.
+type NFTCollectionData struct {
+	Address       string      `json:"address"`
+	Blockchain    string      `json:"blockchain"`
+	Name          string      `json:"name"`
+	Symbol        string      `json:"symbol"`
+	Standard      NFTStandard `json:"standard"`
+	Description   string      `json:"description"`
+	Image         string      `json:"image"`
+	Website       string      `json:"website"`
+	Twitter       string      `json:"twitter"`
+	TotalSupply   int64       `json:"totalSupply"`
+	OwnersCount   int         `json:"ownersCount"`
+	FloorPriceUSD *float64    `json:"floorPriceUSD"`
+	Volume24HUSD  float64     `json:"volume24hUSD"`
+}
+
+type WalletPortfolioData struct {
+	Wallet          string           `json:"wallet"`
+	TotalBalanceUSD float64          `json:"totalBalanceUSD"`
+	UpdatedAt       time.Time        `json:"updatedAt"` // When the balances were last indexed
+	Positions       []WalletPosition `json:"positions"`
+}
+
+// WalletPosition is the balance of one token in a wallet.
+type WalletPosition struct {
+	Blockchain string  `json:"blockchain"`
+	Address    string  `json:"address"`
+	Symbol     string  `json:"symbol"`
+	Name       string  `json:"name"`
+	Decimals   int     `json:"decimals"`
+	Balance    float64 `json:"balance"`    // Balance in token units
+	BalanceRaw string  `json:"balanceRaw"` // Balance in the smallest unit of the token
+	PriceUSD   float64 `json:"priceUSD"`
+	ValueUSD   float64 `json:"valueUSD"`
+}
@@ -0,0 +1,195 @@
+package watch
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Change is one field that differs between two responses. Path is dotted
+// JSON names with indices, such as "data[2].priceUSD"; Old or New is nil for
+// slice elements and map entries that were removed or added.
+type Change struct {
+	Path string `json:"path"`
+	Old  any    `json:"old"`
+	New  any    `json:"new"`
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Tolerance is how far a number may move without counting as a change: the
+// difference must exceed both Abs and Rel times the old value.
+type Tolerance struct {
+	Abs float64
+	Rel float64 // fraction, e.g. 0.01 for 1%
+}
+
+// DiffOptions tune Diff.
+type DiffOptions struct {
+	// Default applies to every number without a more specific tolerance.
+	Default Tolerance
+	// Tolerances apply by field pattern. A pattern is a path with indices
+	// written as [] and map keys as *, like the paths of drift reports:
+	// "data[].priceUSD" or "data.liquidityUSD".
+	Tolerances map[string]Tolerance
+	// Ignore lists field patterns never reported, such as timestamps that
+	// change on every response.
+	Ignore []string
+}
+
+// Diff compares two values of the same type field by field.
+func Diff(old, new any, opts *DiffOptions) []Change {
+	var o DiffOptions
+	if opts != nil {
+		o = *opts
+	}
+	d := &differ{opts: o, ignore: map[string]bool{}}
+	for _, p := range o.Ignore {
+		d.ignore[p] = true
+	}
+	d.walk("", "", reflect.ValueOf(old), reflect.ValueOf(new))
+	return d.changes
+}
+
+type differ struct {
+	opts    DiffOptions
+	ignore  map[string]bool
+	changes []Change
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// walk compares a and b at path; pattern is path with indices as [] and map
+// keys as *.
+func (d *differ) walk(path, pattern string, a, b reflect.Value) {
+	if d.ignore[pattern] {
+		return
+	}
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			d.add(path, iface(a), iface(b))
+		}
+		return
+	}
+	for a.Kind() == reflect.Pointer || a.Kind() == reflect.Interface {
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.add(path, iface(a), iface(b))
+			}
+			return
+		}
+		a, b = a.Elem(), b.Elem()
+		if a.Type() != b.Type() {
+			d.add(path, a.Interface(), b.Interface())
+			return
+		}
+	}
+
+	if a.Type() == timeType {
+		if !a.Interface().(time.Time).Equal(b.Interface().(time.Time)) {
+			d.add(path, a.Interface(), b.Interface())
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		t := a.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			if f.Anonymous && f.Tag.Get("json") == "" {
+				d.walk(path, pattern, a.Field(i), b.Field(i))
+				continue
+			}
+			d.walk(join(path, name), join(pattern, name), a.Field(i), b.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		n := max(a.Len(), b.Len())
+		for i := 0; i < n; i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				d.add(p, nil, b.Index(i).Interface())
+			case i >= b.Len():
+				d.add(p, a.Index(i).Interface(), nil)
+			default:
+				d.walk(p, pattern+"[]", a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Map:
+		keys := map[string]reflect.Value{}
+		for _, k := range append(a.MapKeys(), b.MapKeys()...) {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			d.walk(join(path, name), join(pattern, "*"), a.MapIndex(keys[name]), b.MapIndex(keys[name]))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		d.number(path, pattern, float64(a.Int()), float64(b.Int()), a, b)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		d.number(path, pattern, float64(a.Uint()), float64(b.Uint()), a, b)
+	case reflect.Float32, reflect.Float64:
+		d.number(path, pattern, a.Float(), b.Float(), a, b)
+	default:
+		if a.Comparable() && b.Comparable() {
+			if a.Interface() != b.Interface() {
+				d.add(path, a.Interface(), b.Interface())
+			}
+		} else if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(path, a.Interface(), b.Interface())
+		}
+	}
+}
+
+func (d *differ) number(path, pattern string, x, y float64, a, b reflect.Value) {
+	if x == y || (math.IsNaN(x) && math.IsNaN(y)) {
+		return
+	}
+	tol, ok := d.opts.Tolerances[pattern]
+	if !ok {
+		tol = d.opts.Default
+	}
+	delta := math.Abs(y - x)
+	if delta <= tol.Abs || delta <= tol.Rel*math.Abs(x) {
+		return
+	}
+	d.add(path, a.Interface(), b.Interface())
+}
+
+func (d *differ) add(path string, old, new any) {
+	d.changes = append(d.changes, Change{Path: path, Old: old, New: new})
+}
+
+func iface(v reflect.Value) any {
+	if !v.IsValid() || ((v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && v.IsNil()) {
+		return nil
+	}
+	return v.Interface()
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,132 @@
+// Package watch polls an endpoint and reports what changed between
+// consecutive responses.
+//
+//	w := watch.New(watch.Getter(client, v2.GetTokenDetails, &v2.TokenDetailsRequest{
+//		Address: "0x...", Blockchain: "ethereum",
+//	}), &watch.Options{
+//		Interval: 30 * time.Second,
+//		Diff: watch.DiffOptions{
+//			Default: watch.Tolerance{Rel: 0.001},
+//			Ignore:  []string{"data.latestTradeDate"},
+//		},
+//	})
+//	err := w.Run(ctx, func(e watch.Event[*v2.TokenDetailsResponse]) {
+//		for _, c := range e.Changes { ... }
+//	})
+package watch
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/clock"
+	v2 "github.com/zomvs/mobula-go-sdk/v2"
+)
+
+// Getter binds a generated endpoint function and its request into a fetch
+// function for New.
+func Getter[Req, Resp any](client v2.HTTPClient, get func(context.Context, v2.HTTPClient, *Req) (*Resp, error), req *Req) func(context.Context) (*Resp, error) {
+	return func(ctx context.Context) (*Resp, error) {
+		return get(ctx, client, req)
+	}
+}
+
+// Options tune a Watcher. Backoff only reacts to the server answering 429;
+// a mobula.Config.Limiter paces requests inside fetch instead, so a poll
+// waiting on it just takes longer and the next interval starts once it
+// returns.
+type Options struct {
+	Interval   time.Duration // between polls, default 30s
+	Jitter     float64       // random share added to or removed from each interval, default 0.1, negative for none
+	MaxBackoff time.Duration // longest interval while rate limited, default 5m
+	Diff       DiffOptions
+	Clock      clock.Clock // default clock.System
+}
+
+// Event is the outcome of a poll. The first successful poll has Initial set
+// and no changes; later polls produce an event only when something changed
+// or the fetch failed.
+type Event[T any] struct {
+	Time    time.Time
+	Value   T
+	Initial bool
+	Changes []Change
+	Err     error
+}
+
+// Watcher polls a fetch function. Create one with New.
+type Watcher[T any] struct {
+	fetch func(context.Context) (T, error)
+	opts  Options
+}
+
+// New creates a watcher polling fetch.
+func New[T any](fetch func(context.Context) (T, error), opts *Options) *Watcher[T] {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = 30 * time.Second
+	}
+	if o.Jitter == 0 {
+		o.Jitter = 0.1
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = 5 * time.Minute
+	}
+	if o.Clock == nil {
+		o.Clock = clock.System
+	}
+	return &Watcher[T]{fetch: fetch, opts: o}
+}
+
+// Run polls until ctx is done, passing events to emit. While the API answers
+// 429 Too Many Requests the interval doubles, up to MaxBackoff, and it
+// returns to normal after the next successful poll.
+func (w *Watcher[T]) Run(ctx context.Context, emit func(Event[T])) error {
+	var last T
+	have := false
+	interval := w.opts.Interval
+	for {
+		value, err := w.fetch(ctx)
+		now := w.opts.Clock.Now()
+		switch {
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case err != nil:
+			if mobula.IsRateLimited(err) {
+				interval = min(2*interval, w.opts.MaxBackoff)
+			}
+			emit(Event[T]{Time: now, Err: err})
+		case !have:
+			have, last = true, value
+			interval = w.opts.Interval
+			emit(Event[T]{Time: now, Value: value, Initial: true})
+		default:
+			interval = w.opts.Interval
+			// The baseline only moves when a change is reported, so values
+			// creeping within tolerance poll after poll still surface.
+			if changes := Diff(last, value, &w.opts.Diff); len(changes) > 0 {
+				last = value
+				emit(Event[T]{Time: now, Value: value, Changes: changes})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.opts.Clock.After(w.jitter(interval)):
+		}
+	}
+}
+
+func (w *Watcher[T]) jitter(d time.Duration) time.Duration {
+	if w.opts.Jitter <= 0 {
+		return d
+	}
+	spread := float64(d) * w.opts.Jitter
+	return d + time.Duration(spread*(2*rand.Float64()-1))
+}
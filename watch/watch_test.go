@@ -0,0 +1,265 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	mobula "github.com/zomvs/mobula-go-sdk"
+	"github.com/zomvs/mobula-go-sdk/clock"
+)
+
+type pool struct {
+	PriceUSD  float64            `json:"priceUSD"`
+	Volume    float64            `json:"volume"`
+	Top       *holder            `json:"top"`
+	Labels    map[string]string  `json:"labels"`
+	Balances  map[string]float64 `json:"balances"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+type holder struct {
+	Address string  `json:"address"`
+	Balance float64 `json:"balance"`
+}
+
+func paths(changes []Change) []string {
+	out := []string{}
+	for _, c := range changes {
+		out = append(out, c.Path)
+	}
+	return out
+}
+
+func TestDiffTolerance(t *testing.T) {
+	tests := []struct {
+		name     string
+		tol      Tolerance
+		old, new float64
+		changed  bool
+	}{
+		{"exact", Tolerance{}, 100, 100, false},
+		{"no tolerance", Tolerance{}, 100, 100.0001, true},
+		{"at abs", Tolerance{Abs: 1}, 100, 101, false},
+		{"past abs", Tolerance{Abs: 1}, 100, 101.5, true},
+		{"past abs downwards", Tolerance{Abs: 1}, 100, 98.5, true},
+		{"at rel", Tolerance{Rel: 0.01}, 100, 101, false},
+		{"past rel", Tolerance{Rel: 0.01}, 100, 101.5, true},
+		{"rel of the old value", Tolerance{Rel: 0.5}, 100, 149, false},
+		{"rel of the old value downwards", Tolerance{Rel: 0.5}, 100, 49, true},
+		{"past rel within abs", Tolerance{Abs: 2, Rel: 0.01}, 100, 101.5, false},
+		{"past abs within rel", Tolerance{Abs: 1, Rel: 0.02}, 100, 101.5, false},
+		{"past both", Tolerance{Abs: 1, Rel: 0.01}, 100, 101.5, true},
+		{"rel from zero", Tolerance{Rel: 0.5}, 0, 0.001, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := Diff(pool{PriceUSD: tt.old}, pool{PriceUSD: tt.new}, &DiffOptions{Default: tt.tol})
+			if changed := len(changes) > 0; changed != tt.changed {
+				t.Errorf("changes %v, want changed %v", changes, tt.changed)
+			}
+		})
+	}
+
+	// A pattern overrides the default for its field only.
+	opts := &DiffOptions{Default: Tolerance{Abs: 10}, Tolerances: map[string]Tolerance{"priceUSD": {}}}
+	changes := Diff(pool{PriceUSD: 1, Volume: 1}, pool{PriceUSD: 2, Volume: 2}, opts)
+	if got := paths(changes); !reflect.DeepEqual(got, []string{"priceUSD"}) {
+		t.Errorf("changes %v, want only priceUSD", got)
+	}
+}
+
+func TestDiffShapes(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		old, new pool
+		want     []Change
+	}{
+		{
+			"pointer set",
+			pool{}, pool{Top: &holder{Address: "0xabc", Balance: 1}},
+			[]Change{{Path: "top", Old: nil, New: &holder{Address: "0xabc", Balance: 1}}},
+		},
+		{
+			"pointer cleared",
+			pool{Top: &holder{Address: "0xabc"}}, pool{},
+			[]Change{{Path: "top", Old: &holder{Address: "0xabc"}, New: nil}},
+		},
+		{
+			"pointed to value",
+			pool{Top: &holder{Balance: 1}}, pool{Top: &holder{Balance: 2}},
+			[]Change{{Path: "top.balance", Old: 1.0, New: 2.0}},
+		},
+		{
+			"map keys added and removed",
+			pool{Labels: map[string]string{"a": "x", "b": "y"}},
+			pool{Labels: map[string]string{"b": "y", "c": "z"}},
+			[]Change{{Path: "labels.a", Old: "x", New: nil}, {Path: "labels.c", Old: nil, New: "z"}},
+		},
+		{
+			"map from nil",
+			pool{}, pool{Balances: map[string]float64{"0xabc": 1}},
+			[]Change{{Path: "balances.0xabc", Old: nil, New: 1.0}},
+		},
+		{
+			"same instant in another zone",
+			pool{UpdatedAt: at}, pool{UpdatedAt: at.In(time.FixedZone("CET", 3600))},
+			[]Change{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.old, tt.new, nil)
+			if got == nil {
+				got = []Change{}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changes %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Map tolerances apply through the * pattern.
+	changes := Diff(
+		pool{Balances: map[string]float64{"0xabc": 100, "0xdef": 100}},
+		pool{Balances: map[string]float64{"0xabc": 100.5, "0xdef": 110}},
+		&DiffOptions{Tolerances: map[string]Tolerance{"balances.*": {Abs: 1}}},
+	)
+	if got := paths(changes); !reflect.DeepEqual(got, []string{"balances.0xdef"}) {
+		t.Errorf("changes %v, want only balances.0xdef", got)
+	}
+}
+
+var start = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// waitBlocked waits until Run is blocked on the clock between polls.
+func waitBlocked(t *testing.T, clk *clock.Fake) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for clk.Waiters() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Run never waited for the next poll")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// poll is the outcome of one fetch.
+type poll struct {
+	price float64
+	err   error
+}
+
+// run starts w on polls, one per fetch, and returns the events and the time
+// of every fetch once ctx is cancelled after the last poll. step is called
+// while Run waits after each poll but the last.
+func run(t *testing.T, clk *clock.Fake, polls []poll, opts *Options, step func(i int)) ([]Event[pool], []time.Time) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var fetched []time.Time
+	fetch := func(context.Context) (pool, error) {
+		p := polls[len(fetched)]
+		fetched = append(fetched, clk.Now())
+		return pool{PriceUSD: p.price}, p.err
+	}
+	opts.Clock = clk
+	opts.Jitter = -1
+	var events []Event[pool]
+	done := make(chan error)
+	go func() {
+		done <- New(fetch, opts).Run(ctx, func(e Event[pool]) { events = append(events, e) })
+	}()
+	for i := range polls {
+		waitBlocked(t, clk)
+		if i == len(polls)-1 {
+			break
+		}
+		step(i)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run returned %v", err)
+	}
+	if len(fetched) != len(polls) {
+		t.Fatalf("%d fetches, want %d", len(fetched), len(polls))
+	}
+	return events, fetched
+}
+
+func TestRunBaseline(t *testing.T) {
+	clk := clock.NewFake(start)
+	// Each poll moves within the tolerance of the one before, but the third
+	// is past it from the baseline.
+	polls := []poll{{price: 100}, {price: 100.6}, {price: 101.2}, {price: 101.8}, {price: 102.3}}
+	opts := &Options{Interval: time.Minute, Diff: DiffOptions{Default: Tolerance{Abs: 1}}}
+	events, _ := run(t, clk, polls, opts, func(int) { clk.Advance(time.Minute) })
+
+	type summary struct {
+		initial bool
+		changes []Change
+	}
+	var got []summary
+	for _, e := range events {
+		got = append(got, summary{e.Initial, e.Changes})
+	}
+	want := []summary{
+		{initial: true},
+		{changes: []Change{{Path: "priceUSD", Old: 100.0, New: 101.2}}},
+		{changes: []Change{{Path: "priceUSD", Old: 101.2, New: 102.3}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events %+v, want %+v", got, want)
+	}
+}
+
+func TestRunBackoff(t *testing.T) {
+	clk := clock.NewFake(start)
+	limited := &mobula.APIError{StatusCode: 429}
+	polls := []poll{
+		{price: 1},
+		{err: limited}, {err: limited}, {err: limited}, {err: limited},
+		{price: 1},
+		{err: errors.New("connection reset")},
+		{price: 1},
+	}
+	// The interval waited after each poll but the last.
+	want := []time.Duration{
+		time.Minute,
+		2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute,
+		time.Minute,
+		time.Minute, // only a 429 backs off
+	}
+	opts := &Options{Interval: time.Minute, MaxBackoff: 5 * time.Minute}
+	// Advance in steps of a second, so an early poll would show in its time.
+	events, fetched := run(t, clk, polls, opts, func(i int) {
+		for clk.Waiters() > 0 {
+			clk.Advance(time.Second)
+		}
+	})
+	for i := 1; i < len(fetched); i++ {
+		if got := fetched[i].Sub(fetched[i-1]); got != want[i-1] {
+			t.Errorf("poll %d came %s after the one before, want %s", i+1, got, want[i-1])
+		}
+	}
+
+	var errs int
+	for _, e := range events {
+		if e.Err != nil {
+			errs++
+		}
+	}
+	if errs != 5 {
+		t.Errorf("%d error events, want 5", errs)
+	}
+	var waited time.Duration
+	for _, d := range want[:len(want)-1] {
+		waited += d
+	}
+	if last := events[len(events)-1]; !last.Time.Equal(start.Add(waited)) || last.Err == nil {
+		t.Errorf("last event %+v, want the connection error at %s", last, start.Add(waited))
+	}
+}